@@ -1,12 +1,20 @@
 package abacatepay
 
 import (
+	"context"
 	"errors"
+	"log/slog"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
 	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/coupon"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/customer"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/pixqrcode"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/store"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/withdraw"
 )
 
 const Version = "v0.1.0"
@@ -16,19 +24,61 @@ const DefaultTimeout = 500 * time.Millisecond
 type Client struct {
 	httpClient *fetch.Fetch
 	Billing    *billing.Billing
+	Customer   *customer.Customer
+	PixQrCode  *pixqrcode.PixQrCode
+	Coupon     *coupon.Coupon
+	Withdraw   *withdraw.Withdraw
+	Store      *store.Store
 }
 
 type ClientConfig struct {
 	Url     string
 	ApiKey  string
 	Timeout time.Duration
+	// Retry define a política de novas tentativas. Quando nil, é usada a
+	// DefaultRetryPolicy; use MaxAttempts igual a 1 para desabilitar.
+	Retry *RetryPolicy
+	// HTTPClient permite usar um *http.Client próprio (proxy, TLS, transporte
+	// instrumentado). Quando nil, o SDK mantém um único cliente reutilizado
+	// em todas as chamadas, usando Transport se informado.
+	HTTPClient *http.Client
+	Transport  http.RoundTripper
+	// Logger registra cada tentativa de requisição com método, caminho,
+	// status, duração, tentativa e X-Request-Id. Quando nil, nada é
+	// registrado.
+	Logger *slog.Logger
+	// Log define os níveis dos registros e se os corpos são incluídos.
+	// Quando nil, é usada a DefaultLogOptions.
+	Log *LogOptions
+	// Middleware envolve cada operação do SDK, como billing.Create, uma única
+	// vez mesmo quando há novas tentativas. É o ponto de extensão usado pela
+	// instrumentação com OpenTelemetry.
+	Middleware []Middleware
 }
 
-type RequestOptions struct {
-	Timeout time.Duration
-	Headers map[string]string
+type RetryPolicy = fetch.RetryPolicy
+
+var DefaultRetryPolicy = fetch.DefaultRetryPolicy
+
+type LogOptions = fetch.LogOptions
+
+var DefaultLogOptions = fetch.DefaultLogOptions
+
+type (
+	Middleware = fetch.Middleware
+	Handler    = fetch.Handler
+)
+
+// Operation retorna o nome da operação do SDK em andamento em ctx, como
+// "abacatepay.billing.create". Use-o dentro de um Middleware.
+func Operation(ctx context.Context) string {
+	return fetch.Operation(ctx)
 }
 
+// RequestOptions pode ser passado a qualquer método dos recursos para
+// sobrescrever as configurações do cliente em uma única chamada.
+type RequestOptions = fetch.RequestOptions
+
 var (
 	ErrInvalidAPIKey = errors.New("invalid API key")
 )
@@ -51,7 +101,27 @@ func New(config *ClientConfig) (*Client, error) {
 		timeout = DefaultTimeout
 	}
 
-	httpClient, err := fetch.New(config.ApiKey, apiUrl, Version, timeout)
+	var opts []fetch.Option
+	if config.Retry != nil {
+		opts = append(opts, fetch.WithRetryPolicy(*config.Retry))
+	}
+	if config.HTTPClient != nil {
+		opts = append(opts, fetch.WithHTTPClient(config.HTTPClient))
+	} else if config.Transport != nil {
+		opts = append(opts, fetch.WithHTTPClient(&http.Client{Transport: config.Transport}))
+	}
+	if config.Logger != nil {
+		logOptions := DefaultLogOptions
+		if config.Log != nil {
+			logOptions = *config.Log
+		}
+		opts = append(opts, fetch.WithLogger(config.Logger, logOptions))
+	}
+	if len(config.Middleware) > 0 {
+		opts = append(opts, fetch.WithMiddleware(config.Middleware...))
+	}
+
+	httpClient, err := fetch.New(config.ApiKey, apiUrl, Version, timeout, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,5 +129,10 @@ func New(config *ClientConfig) (*Client, error) {
 	return &Client{
 		httpClient: httpClient,
 		Billing:    billing.New(httpClient),
+		Customer:   customer.New(httpClient),
+		PixQrCode:  pixqrcode.New(httpClient),
+		Coupon:     coupon.New(httpClient),
+		Withdraw:   withdraw.New(httpClient),
+		Store:      store.New(httpClient),
 	}, nil
 }
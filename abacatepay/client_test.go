@@ -1,7 +1,13 @@
 package abacatepay_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"github.com/AbacatePay/abacatepay-go-sdk/abacatepay"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -28,3 +34,61 @@ func TestNew(t *testing.T) {
 		assert.Nil(t, cl)
 	})
 }
+
+type countingTransport struct {
+	calls int
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.calls++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestHTTPClient(t *testing.T) {
+	t.Run("Use custom transport", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"id": "store_1234"}})
+		}))
+		defer server.Close()
+
+		transport := &countingTransport{}
+		cl, err := abacatepay.New(&abacatepay.ClientConfig{
+			Url:       server.URL,
+			ApiKey:    "test-key",
+			Transport: transport,
+		})
+		assert.NoError(t, err)
+
+		_, err = cl.Store.Get(context.Background())
+		assert.NoError(t, err)
+
+		_, err = cl.Store.Get(context.Background())
+		assert.NoError(t, err)
+
+		assert.Equal(t, 2, transport.calls)
+	})
+}
+
+func TestLogger(t *testing.T) {
+	t.Run("Log requests with the configured logger", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"id": "store_1234"}})
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		cl, err := abacatepay.New(&abacatepay.ClientConfig{
+			Url:    server.URL,
+			ApiKey: "test-key",
+			Logger: slog.New(slog.NewTextHandler(&buf, nil)),
+			Log:    &abacatepay.LogOptions{Level: slog.LevelInfo},
+		})
+		assert.NoError(t, err)
+
+		_, err = cl.Store.Get(context.Background())
+		assert.NoError(t, err)
+
+		assert.Contains(t, buf.String(), "path=/v1/store/get")
+		assert.Contains(t, buf.String(), "status=200")
+	})
+}
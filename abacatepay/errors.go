@@ -0,0 +1,89 @@
+package abacatepay
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
+)
+
+// APIError é o erro retornado por todos os recursos quando a API responde
+// com um status fora da faixa 2xx ou com uma resposta 2xx cujo envelope traz
+// o campo error preenchido; nesse caso StatusCode é o status 2xx recebido,
+// normalmente 200, e IsValidation, IsNotFound e os demais helpers por status
+// não o reconhecem. Use errors.As para inspecioná-lo.
+type APIError = fetch.APIError
+
+// RequestError é retornado quando a requisição falha sem resposta da API,
+// por exemplo por timeout.
+type RequestError = fetch.RequestError
+
+// ValidationError é retornado pelo Validate dos corpos de requisição antes de
+// qualquer chamada à API. Cada item de Fields traz o caminho do campo com os
+// nomes do JSON, como products[0].price, e a regra que falhou.
+type ValidationError = validation.ValidationError
+
+type FieldError = validation.FieldError
+
+// Language seleciona o idioma de FieldError.Message e ValidationError.Messages.
+type Language = validation.Language
+
+const (
+	PtBR = validation.PtBR
+	EN   = validation.EN
+)
+
+// IdempotencyKey retorna a chave de idempotência da requisição que originou
+// err, ou uma string vazia se ela não estiver disponível.
+func IdempotencyKey(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IdempotencyKey
+	}
+
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.IdempotencyKey
+	}
+
+	return ""
+}
+
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsValidation indica se err é um ValidationError local ou uma resposta 400
+// ou 422 da API.
+func IsValidation(err error) bool {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return true
+	}
+
+	return hasStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
+func hasStatus(err error, codes ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
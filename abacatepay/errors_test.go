@@ -0,0 +1,109 @@
+package abacatepay_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/abacatepay"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/customer"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Run("Resource methods return APIError", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req_1234")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"data": null, "error": "Store not found"}`))
+		}))
+		defer server.Close()
+
+		client, err := abacatepay.New(&abacatepay.ClientConfig{
+			Url:     server.URL,
+			ApiKey:  "test-key",
+			Timeout: 10 * time.Second,
+		})
+		assert.NoError(t, err)
+
+		_, err = client.Store.Get(context.Background())
+
+		var apiErr *abacatepay.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, "Store not found", apiErr.Message)
+		assert.Equal(t, "req_1234", apiErr.RequestID)
+		assert.Equal(t, http.MethodGet, apiErr.Method)
+		assert.Equal(t, "/v1/store/get", apiErr.Endpoint)
+		assert.True(t, abacatepay.IsNotFound(err))
+	})
+
+	t.Run("Helpers match status codes", func(t *testing.T) {
+		wrap := func(status int) error {
+			return fmt.Errorf("wrapped: %w", &abacatepay.APIError{StatusCode: status})
+		}
+
+		assert.True(t, abacatepay.IsUnauthorized(wrap(http.StatusUnauthorized)))
+		assert.True(t, abacatepay.IsNotFound(wrap(http.StatusNotFound)))
+		assert.True(t, abacatepay.IsRateLimited(wrap(http.StatusTooManyRequests)))
+		assert.True(t, abacatepay.IsValidation(wrap(http.StatusBadRequest)))
+		assert.True(t, abacatepay.IsValidation(wrap(http.StatusUnprocessableEntity)))
+		assert.False(t, abacatepay.IsUnauthorized(wrap(http.StatusInternalServerError)))
+		assert.False(t, abacatepay.IsNotFound(errors.New("not an api error")))
+	})
+
+	t.Run("Expose idempotency key of failed POST", func(t *testing.T) {
+		var sent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sent = r.Header.Get("Idempotency-Key")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"data": null, "error": "Invalid body"}`))
+		}))
+		defer server.Close()
+
+		client, err := abacatepay.New(&abacatepay.ClientConfig{
+			Url:     server.URL,
+			ApiKey:  "test-key",
+			Timeout: 10 * time.Second,
+		})
+		assert.NoError(t, err)
+
+		_, err = client.Customer.Create(context.Background(), &customer.CreateCustomerBody{
+			Name:      "Test",
+			Cellphone: "(11) 9 8765-4321",
+			Email:     "test@example.com",
+			TaxID:     "529.982.247-25",
+		})
+
+		assert.True(t, abacatepay.IsValidation(err))
+		assert.NotEmpty(t, sent)
+		assert.Equal(t, sent, abacatepay.IdempotencyKey(err))
+	})
+}
+
+func TestValidationError(t *testing.T) {
+	t.Run("Request bodies return ValidationError", func(t *testing.T) {
+		body := &customer.CreateCustomerBody{
+			Name:      "John Doe",
+			Cellphone: "(11) 4002-8922",
+			Email:     "not-an-email",
+			TaxID:     "529.982.247-25",
+		}
+
+		err := body.Validate()
+
+		var validationErr *abacatepay.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.True(t, abacatepay.IsValidation(err))
+		assert.Equal(t, []string{
+			"cellphone deve ser um celular brasileiro válido",
+			"email deve ser um e-mail válido",
+		}, validationErr.Messages(abacatepay.PtBR))
+		assert.Equal(t, "validation failed: cellphone must be a valid Brazilian mobile number; email must be a valid email address", err.Error())
+	})
+}
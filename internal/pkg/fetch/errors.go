@@ -0,0 +1,102 @@
+package fetch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+// APIError é retornado quando a API responde com um status fora da faixa 2xx
+// ou com uma resposta 2xx cujo envelope traz o campo error preenchido. Nesse
+// último caso StatusCode é o status 2xx recebido, e os helpers por status do
+// pacote abacatepay, como IsValidation e IsNotFound, não o reconhecem.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Body       string
+	RequestID  string
+	Endpoint   string
+	Method     string
+	// IdempotencyKey é a chave enviada na requisição, útil para logs e
+	// para repetir a chamada com segurança.
+	IdempotencyKey string
+}
+
+// RequestError é retornado quando a requisição falha antes de receber uma
+// resposta da API, por exemplo por timeout ou erro de conexão.
+type RequestError struct {
+	Endpoint       string
+	Method         string
+	IdempotencyKey string
+	Err            error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("error on request: %s %s: %v", e.Method, e.Endpoint, e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+func newRequestError(req *http.Request, err error) *RequestError {
+	return &RequestError{
+		Endpoint:       req.URL.Path,
+		Method:         req.Method,
+		IdempotencyKey: req.Header.Get(IdempotencyKeyHeader),
+		Err:            err,
+	}
+}
+
+func (e *APIError) Error() string {
+	if e.Method != "" && e.Endpoint != "" {
+		return fmt.Sprintf("error on request: status %d, body: %s (%s %s)", e.StatusCode, e.Body, e.Method, e.Endpoint)
+	}
+
+	return fmt.Sprintf("error on request: status %d, body: %s", e.StatusCode, e.Body)
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    errorMessage(body),
+		Body:       string(body),
+	}
+
+	if resp.Header != nil {
+		apiErr.RequestID = resp.Header.Get(requestIDHeader)
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.IdempotencyKey = resp.Request.Header.Get(IdempotencyKeyHeader)
+		if resp.Request.URL != nil {
+			apiErr.Endpoint = resp.Request.URL.Path
+		}
+	}
+
+	return apiErr
+}
+
+func errorMessage(body []byte) string {
+	var envelope struct {
+		Error interface{} `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.Error == nil {
+		return ""
+	}
+
+	if msg, ok := envelope.Error.(string); ok {
+		return msg
+	}
+
+	raw, err := json.Marshal(envelope.Error)
+	if err != nil {
+		return ""
+	}
+
+	return string(raw)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"time"
 )
@@ -21,14 +22,60 @@ type Fetch struct {
 	apiUrl  string
 	version string
 	timeout time.Duration
+	retry   RetryPolicy
+	client  *http.Client
+	logger  *slog.Logger
+	log     LogOptions
+
+	middlewares []Middleware
+}
+
+type Option func(*Fetch)
+
+// WithHTTPClient substitui o *http.Client usado em todas as requisições.
+// O timeout do cliente é aplicado pelo contexto de cada requisição, então
+// o campo Timeout do *http.Client recebido não precisa ser configurado.
+func WithHTTPClient(client *http.Client) Option {
+	return func(f *Fetch) {
+		if client != nil {
+			f.client = client
+		}
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(f *Fetch) {
+		f.retry = policy
+	}
+}
+
+// Response é o envelope padrão das respostas da API.
+type Response[T any] struct {
+	Data  T      `json:"data"`
+	Error string `json:"error"`
+	// Pagination só é retornado pelos endpoints de listagem paginados.
+	Pagination *Pagination `json:"pagination,omitempty"`
+	// IdempotencyKey é a chave enviada na requisição que gerou esta resposta.
+	IdempotencyKey string `json:"-"`
+}
+
+func (r *Response[T]) setIdempotencyKey(key string) {
+	r.IdempotencyKey = key
 }
 
 type RequestOptions struct {
 	Timeout time.Duration
 	Headers map[string]string
+	// IdempotencyKey substitui a chave gerada automaticamente para métodos
+	// que alteram dados.
+	IdempotencyKey string
+	// ApiKey substitui a chave de API do cliente nesta requisição.
+	ApiKey string
+	// Retry substitui a política de novas tentativas do cliente.
+	Retry *RetryPolicy
 }
 
-func New(apiKey, apiUrl, version string, timeout time.Duration) (*Fetch, error) {
+func New(apiKey, apiUrl, version string, timeout time.Duration, opts ...Option) (*Fetch, error) {
 	if apiKey == "" {
 		return nil, ErrInvalidAPIKey
 	}
@@ -37,27 +84,37 @@ func New(apiKey, apiUrl, version string, timeout time.Duration) (*Fetch, error)
 		return nil, ErrInvalidAPIUrl
 	}
 
-	return &Fetch{
+	f := &Fetch{
 		apiKey:  apiKey,
 		apiUrl:  apiUrl,
 		version: version,
 		timeout: timeout,
-	}, nil
+		retry:   DefaultRetryPolicy,
+		client:  &http.Client{},
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f, nil
 }
 
 func (f *Fetch) Request(ctx context.Context, method, endpoint string, body interface{}, opts ...RequestOptions) (*http.Response, error) {
 	url := fmt.Sprintf("%s%s", f.apiUrl, endpoint)
 
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("error on serializing request body: %v", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	ctx = context.WithValue(ctx, operationKey{}, operationName(endpoint))
+
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error on creating request: %v", err)
 	}
@@ -67,6 +124,7 @@ func (f *Fetch) Request(ctx context.Context, method, endpoint string, body inter
 	req.Header.Set("User-Agent", fmt.Sprintf("AbacatePay-Go-SDK/%s", f.version))
 
 	var timeout time.Duration = f.timeout
+	retry := f.retry
 
 	if len(opts) > 0 {
 		opt := opts[0]
@@ -75,16 +133,127 @@ func (f *Fetch) Request(ctx context.Context, method, endpoint string, body inter
 			timeout = opt.Timeout
 		}
 
+		if opt.ApiKey != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", opt.ApiKey))
+		}
+
+		if opt.Retry != nil {
+			retry = *opt.Retry
+		}
+
 		for k, v := range opt.Headers {
 			req.Header.Set(k, v)
 		}
+
+		if opt.IdempotencyKey != "" {
+			req.Header.Set(IdempotencyKeyHeader, opt.IdempotencyKey)
+		}
 	}
 
-	client := &http.Client{
-		Timeout: timeout,
+	if isMutating(method) && req.Header.Get(IdempotencyKeyHeader) == "" {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, fmt.Errorf("error on generating idempotency key: %v", err)
+		}
+		req.Header.Set(IdempotencyKeyHeader, key)
 	}
 
-	return client.Do(req)
+	send := func(req *http.Request) (*http.Response, error) {
+		return f.send(req, jsonBody, timeout, retry)
+	}
+
+	return f.wrap(send)(req)
+}
+
+// send executa as tentativas de req no contexto da requisição.
+func (f *Fetch) send(req *http.Request, jsonBody []byte, timeout time.Duration, retry RetryPolicy) (*http.Response, error) {
+	ctx := req.Context()
+	retry = retry.withDefaults()
+
+	attempts := 1
+	if retry.canRetry(req) {
+		attempts = retry.attempts()
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := withTimeout(ctx, timeout)
+
+		attemptReq := req.Clone(attemptCtx)
+		if jsonBody != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
+			attemptReq.ContentLength = int64(len(jsonBody))
+		}
+
+		start := time.Now()
+		resp, err := f.client.Do(attemptReq)
+		duration := time.Since(start)
+		if err != nil {
+			cancel()
+		} else {
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+		}
+
+		var delay time.Duration
+		last := attempt >= attempts || !retry.shouldRetry(ctx, resp, err)
+		if !last {
+			var ok bool
+			delay, ok = retry.delay(attempt, resp)
+			last = !ok
+		}
+
+		f.logAttempt(ctx, attemptReq, jsonBody, resp, err, attempt, duration, !last)
+
+		if last {
+			if err != nil {
+				return nil, newRequestError(req, err)
+			}
+			return envelopeError(resp)
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, newRequestError(req, err)
+		}
+	}
+}
+
+// envelopeError retorna um *APIError quando uma resposta 2xx traz o campo
+// error preenchido, para que os middlewares a vejam como falha.
+func envelopeError(resp *http.Response) (*http.Response, error) {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, nil
+	}
+
+	if body := bufferBody(resp); errorMessage(body) != "" {
+		return nil, newAPIError(resp, body)
+	}
+
+	return resp, nil
+}
+
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// cancelOnClose libera o contexto da tentativa quando o corpo da resposta é
+// fechado, permitindo que o timeout cubra também a leitura do corpo.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
 }
 
 func (f *Fetch) Get(ctx context.Context, endpoint string, opts ...RequestOptions) (*http.Response, error) {
@@ -112,13 +281,21 @@ func ParseResponse(resp *http.Response, target interface{}) error {
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("error on request: status %d, body: %s", resp.StatusCode, string(body))
+		return newAPIError(resp, body)
+	}
+
+	if errorMessage(body) != "" {
+		return newAPIError(resp, body)
 	}
 
 	if target != nil {
 		if err := json.Unmarshal(body, target); err != nil {
 			return fmt.Errorf("error on deserializing response: %v", err)
 		}
+
+		if r, ok := target.(interface{ setIdempotencyKey(string) }); ok && resp.Request != nil {
+			r.setIdempotencyKey(resp.Request.Header.Get(IdempotencyKeyHeader))
+		}
 	}
 
 	return nil
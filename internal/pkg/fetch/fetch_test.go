@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
@@ -166,6 +168,54 @@ func TestParseResponse(t *testing.T) {
 		assert.Contains(t, err.Error(), "error on request: status 400")
 	})
 
+	t.Run("Error on success status with error envelope", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"data": null, "error": "Billing not found"}`)),
+		}
+
+		var result fetch.Response[TestResponse]
+		err := fetch.ParseResponse(resp, &result)
+
+		var apiErr *fetch.APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 200, apiErr.StatusCode)
+		assert.Equal(t, "Billing not found", apiErr.Message)
+	})
+
+	t.Run("Parse generic response envelope", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"data": {"message": "Success"}, "error": null}`)),
+		}
+
+		var result fetch.Response[TestResponse]
+		err := fetch.ParseResponse(resp, &result)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Success", result.Data.Message)
+	})
+
+	t.Run("Return APIError with request details", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "https://api.test.com/v1/billing/create", nil)
+		resp := &http.Response{
+			StatusCode: 401,
+			Header:     http.Header{"X-Request-Id": []string{"req_1234"}},
+			Body:       io.NopCloser(bytes.NewBufferString(`{"data": null, "error": "Invalid API key"}`)),
+			Request:    req,
+		}
+
+		err := fetch.ParseResponse(resp, nil)
+
+		var apiErr *fetch.APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 401, apiErr.StatusCode)
+		assert.Equal(t, "Invalid API key", apiErr.Message)
+		assert.Equal(t, "req_1234", apiErr.RequestID)
+		assert.Equal(t, http.MethodPost, apiErr.Method)
+		assert.Equal(t, "/v1/billing/create", apiErr.Endpoint)
+	})
+
 	t.Run("Error with invalid JSON", func(t *testing.T) {
 		resp := &http.Response{
 			StatusCode: 200,
@@ -201,4 +251,618 @@ func TestRequestOptions(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, response)
 	})
+
+	t.Run("Override API key", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer other-key", r.Header.Get("Authorization"))
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		_, err = client.Get(context.Background(), "/test", fetch.RequestOptions{ApiKey: "other-key"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Override retry policy", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		_, err = client.Get(context.Background(), "/test", fetch.RequestOptions{
+			Retry: &fetch.RetryPolicy{MaxAttempts: 1},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRetry(t *testing.T) {
+	policy := fetch.RetryPolicy{
+		MaxAttempts:     3,
+		BaseDelay:       time.Millisecond,
+		MaxDelay:        10 * time.Millisecond,
+		Jitter:          0.5,
+		RetryableStatus: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}
+
+	t.Run("Retry GET on retryable status", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			json.NewEncoder(w).Encode(TestResponse{Message: "Success"})
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithRetryPolicy(policy))
+		assert.NoError(t, err)
+
+		response, err := client.Get(context.Background(), "/test")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Stop after max attempts", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithRetryPolicy(policy))
+		assert.NoError(t, err)
+
+		response, err := client.Get(context.Background(), "/test")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Retry POST reusing the generated idempotency key", func(t *testing.T) {
+		var keys []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			keys = append(keys, r.Header.Get(fetch.IdempotencyKeyHeader))
+			if len(keys) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			json.NewEncoder(w).Encode(TestResponse{Message: "Success"})
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithRetryPolicy(policy))
+		assert.NoError(t, err)
+
+		_, err = client.Post(context.Background(), "/test", TestResponse{Message: "Success"})
+
+		assert.NoError(t, err)
+		assert.Len(t, keys, 2)
+		assert.NotEmpty(t, keys[0])
+		assert.Equal(t, keys[0], keys[1])
+	})
+
+	t.Run("Retry POST with idempotency key resending the body", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body TestResponse
+
+			calls++
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "Success", body.Message)
+			assert.Equal(t, "key-1234", r.Header.Get(fetch.IdempotencyKeyHeader))
+
+			if calls == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			json.NewEncoder(w).Encode(body)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithRetryPolicy(policy))
+		assert.NoError(t, err)
+
+		opts := fetch.RequestOptions{
+			IdempotencyKey: "key-1234",
+		}
+
+		response, err := client.Post(context.Background(), "/test", TestResponse{Message: "Success"}, opts)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Wait the full Retry-After within MaxDelay", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte(`{"message": "Success"}`))
+		}))
+		defer server.Close()
+
+		patient := policy
+		patient.MaxDelay = 2 * time.Second
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithRetryPolicy(patient))
+		assert.NoError(t, err)
+
+		start := time.Now()
+		response, err := client.Get(context.Background(), "/test")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Return the response when Retry-After exceeds MaxDelay", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithRetryPolicy(policy))
+		assert.NoError(t, err)
+
+		start := time.Now()
+		response, err := client.Get(context.Background(), "/test")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Fill unset fields from the default policy", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"message": "Success"}`))
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		start := time.Now()
+		response, err := client.Get(context.Background(), "/test", fetch.RequestOptions{
+			Retry: &fetch.RetryPolicy{MaxAttempts: 5},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.GreaterOrEqual(t, time.Since(start), time.Duration(float64(fetch.DefaultRetryPolicy.BaseDelay)*(1-fetch.DefaultRetryPolicy.Jitter)))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Apply the default jitter unless it is disabled", func(t *testing.T) {
+		gaps := func(jitter float64) []time.Duration {
+			var last time.Time
+			var gaps []time.Duration
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !last.IsZero() {
+					gaps = append(gaps, time.Since(last))
+				}
+				last = time.Now()
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+			assert.NoError(t, err)
+
+			response, err := client.Get(context.Background(), "/test", fetch.RequestOptions{
+				Retry: &fetch.RetryPolicy{
+					MaxAttempts: 6,
+					BaseDelay:   50 * time.Millisecond,
+					MaxDelay:    50 * time.Millisecond,
+					Jitter:      jitter,
+				},
+			})
+			assert.NoError(t, err)
+			response.Body.Close()
+
+			return gaps
+		}
+
+		jittered := gaps(0)
+		assert.Len(t, jittered, 5)
+		for _, gap := range jittered {
+			assert.GreaterOrEqual(t, gap, 40*time.Millisecond)
+		}
+		assert.Less(t, slices.Min(jittered), 50*time.Millisecond)
+
+		for _, gap := range gaps(-1) {
+			assert.GreaterOrEqual(t, gap, 50*time.Millisecond)
+		}
+	})
+
+	t.Run("Respect context cancellation between attempts", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		slow := policy
+		slow.MaxDelay = time.Minute
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithRetryPolicy(slow))
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err = client.Get(ctx, "/test")
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Run("Send generated key on POST and expose it on the response", func(t *testing.T) {
+		var sent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sent = r.Header.Get(fetch.IdempotencyKeyHeader)
+			json.NewEncoder(w).Encode(fetch.Response[TestResponse]{Data: TestResponse{Message: "Success"}})
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		resp, err := client.Post(context.Background(), "/test", TestResponse{Message: "Success"})
+		assert.NoError(t, err)
+
+		var result fetch.Response[TestResponse]
+		err = fetch.ParseResponse(resp, &result)
+
+		assert.NoError(t, err)
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, sent)
+		assert.Equal(t, sent, result.IdempotencyKey)
+	})
+
+	t.Run("Do not send key on GET", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get(fetch.IdempotencyKeyHeader))
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		_, err = client.Get(context.Background(), "/test")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Expose key on API errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		opts := fetch.RequestOptions{IdempotencyKey: "key-1234"}
+		resp, err := client.Post(context.Background(), "/test", nil, opts)
+		assert.NoError(t, err)
+
+		err = fetch.ParseResponse(resp, nil)
+
+		var apiErr *fetch.APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "key-1234", apiErr.IdempotencyKey)
+	})
+
+	t.Run("Expose key on request errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(50 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Millisecond)
+		assert.NoError(t, err)
+
+		opts := fetch.RequestOptions{
+			IdempotencyKey: "key-1234",
+			Retry:          &fetch.RetryPolicy{MaxAttempts: 1},
+		}
+		_, err = client.Post(context.Background(), "/test", nil, opts)
+
+		var reqErr *fetch.RequestError
+		assert.ErrorAs(t, err, &reqErr)
+		assert.Equal(t, "key-1234", reqErr.IdempotencyKey)
+		assert.Equal(t, http.MethodPost, reqErr.Method)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestHTTPClient(t *testing.T) {
+	t.Run("Use custom http client", func(t *testing.T) {
+		calls := 0
+		httpClient := &http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				calls++
+				assert.Equal(t, "/test", r.URL.Path)
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(bytes.NewBufferString(`{"message": "Success"}`)),
+					Request:    r,
+				}, nil
+			}),
+		}
+
+		client, err := fetch.New("test-key", "https://api.test.com", "1.0.0", 10*time.Second, fetch.WithHTTPClient(httpClient))
+		assert.NoError(t, err)
+
+		resp, err := client.Get(context.Background(), "/test")
+		assert.NoError(t, err)
+
+		var result TestResponse
+		err = fetch.ParseResponse(resp, &result)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Success", result.Message)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Apply timeout through the request context", func(t *testing.T) {
+		var deadline time.Time
+		httpClient := &http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				deadline, _ = r.Context().Deadline()
+				<-r.Context().Done()
+				return nil, r.Context().Err()
+			}),
+		}
+
+		client, err := fetch.New(
+			"test-key", "https://api.test.com", "1.0.0", 10*time.Millisecond,
+			fetch.WithHTTPClient(httpClient),
+			fetch.WithRetryPolicy(fetch.RetryPolicy{MaxAttempts: 1}),
+		)
+		assert.NoError(t, err)
+
+		_, err = client.Get(context.Background(), "/test")
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.False(t, deadline.IsZero())
+		assert.Zero(t, httpClient.Timeout)
+	})
+}
+
+func TestLogger(t *testing.T) {
+	newLogger := func(buf *bytes.Buffer) *slog.Logger {
+		return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+
+	t.Run("Log each attempt with request metadata", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("X-Request-Id", "req_1234")
+			if calls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"message": "Success"}`))
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		client, err := fetch.New(
+			"test-key", server.URL, "1.0.0", 10*time.Second,
+			fetch.WithRetryPolicy(fetch.RetryPolicy{
+				MaxAttempts:     2,
+				BaseDelay:       time.Millisecond,
+				RetryableStatus: []int{http.StatusServiceUnavailable},
+			}),
+			fetch.WithLogger(newLogger(&buf), fetch.DefaultLogOptions),
+		)
+		assert.NoError(t, err)
+
+		resp, err := client.Get(context.Background(), "/test?id=1")
+		assert.NoError(t, err)
+		assert.NoError(t, fetch.ParseResponse(resp, nil))
+
+		var entries []map[string]interface{}
+		decoder := json.NewDecoder(&buf)
+		for decoder.More() {
+			var entry map[string]interface{}
+			assert.NoError(t, decoder.Decode(&entry))
+			entries = append(entries, entry)
+		}
+
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "WARN", entries[0]["level"])
+		assert.Equal(t, float64(503), entries[0]["status"])
+		assert.Equal(t, true, entries[0]["retrying"])
+		assert.Equal(t, "DEBUG", entries[1]["level"])
+		assert.Equal(t, "GET", entries[1]["method"])
+		assert.Equal(t, "/test", entries[1]["path"])
+		assert.Equal(t, float64(200), entries[1]["status"])
+		assert.Equal(t, float64(2), entries[1]["attempt"])
+		assert.Equal(t, "req_1234", entries[1]["request_id"])
+		assert.Contains(t, entries[1], "duration")
+		assert.NotContains(t, entries[1], "response_body")
+	})
+
+	t.Run("Redact API key and PII from bodies", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data": {"id": "cust_1", "metadata": {"email": "john@example.com", "taxId": "52998224725"}}, "error": null}`))
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		client, err := fetch.New(
+			"secret-key", server.URL, "1.0.0", 10*time.Second,
+			fetch.WithLogger(newLogger(&buf), fetch.LogOptions{Level: slog.LevelInfo, Bodies: true}),
+		)
+		assert.NoError(t, err)
+
+		resp, err := client.Post(context.Background(), "/test", map[string]interface{}{
+			"name":      "John Doe",
+			"cellphone": "+5511987654321",
+			"note":      "key secret-key",
+			"amount":    12345678901234,
+		})
+		assert.NoError(t, err)
+
+		var result struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		assert.NoError(t, fetch.ParseResponse(resp, &result))
+		assert.Equal(t, "cust_1", result.Data.ID)
+
+		logged := buf.String()
+		assert.Contains(t, logged, "John Doe")
+		assert.Contains(t, logged, "12345678901234")
+		assert.Contains(t, logged, "cust_1")
+		assert.NotContains(t, logged, "secret-key")
+		assert.NotContains(t, logged, "5511987654321")
+		assert.NotContains(t, logged, "john@example.com")
+		assert.NotContains(t, logged, "52998224725")
+	})
+
+	t.Run("Skip attempts below the logger level", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"message": "Success"}`))
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithLogger(logger, fetch.DefaultLogOptions))
+		assert.NoError(t, err)
+
+		resp, err := client.Get(context.Background(), "/test")
+		assert.NoError(t, err)
+		assert.NoError(t, fetch.ParseResponse(resp, nil))
+
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Wrap the operation once across retries", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			assert.Equal(t, "outer,inner", r.Header.Get("X-Trace"))
+			if calls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"message": "Success"}`))
+		}))
+		defer server.Close()
+
+		var operations []string
+		trace := func(name string) fetch.Middleware {
+			return func(next fetch.Handler) fetch.Handler {
+				return func(req *http.Request) (*http.Response, error) {
+					operations = append(operations, name+":"+fetch.Operation(req.Context()))
+					if current := req.Header.Get("X-Trace"); current != "" {
+						name = current + "," + name
+					}
+					req.Header.Set("X-Trace", name)
+					return next(req)
+				}
+			}
+		}
+
+		client, err := fetch.New(
+			"test-key", server.URL, "1.0.0", 10*time.Second,
+			fetch.WithRetryPolicy(fetch.RetryPolicy{
+				MaxAttempts:     2,
+				BaseDelay:       time.Millisecond,
+				RetryableStatus: []int{http.StatusServiceUnavailable},
+			}),
+			fetch.WithMiddleware(trace("outer"), trace("inner")),
+		)
+		assert.NoError(t, err)
+
+		resp, err := client.Post(context.Background(), "/v1/pixQrCode/simulate-payment?id=1", nil)
+		assert.NoError(t, err)
+		assert.NoError(t, fetch.ParseResponse(resp, nil))
+
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, []string{
+			"outer:abacatepay.pixqrcode.simulate_payment",
+			"inner:abacatepay.pixqrcode.simulate_payment",
+		}, operations)
+	})
+
+	t.Run("Return 2xx error envelopes as APIError to middlewares", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data": null, "error": "Store not found"}`))
+		}))
+		defer server.Close()
+
+		var seen error
+		record := func(next fetch.Handler) fetch.Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				resp, err := next(req)
+				seen = err
+				return resp, err
+			}
+		}
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second, fetch.WithMiddleware(record))
+		assert.NoError(t, err)
+
+		_, err = client.Get(context.Background(), "/v1/store/get")
+
+		var apiErr *fetch.APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusOK, apiErr.StatusCode)
+		assert.Equal(t, "Store not found", apiErr.Message)
+		assert.Equal(t, err, seen)
+	})
 }
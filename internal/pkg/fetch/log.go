@@ -0,0 +1,176 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// LogOptions define como as requisições são registradas pelo logger do
+// cliente.
+type LogOptions struct {
+	// Level é o nível das tentativas concluídas com status abaixo de 400.
+	Level slog.Level
+	// ErrorLevel é o nível das tentativas com erro de rede, status 4xx/5xx
+	// ou que serão repetidas.
+	ErrorLevel slog.Level
+	// Bodies inclui os corpos de requisição e resposta nos registros. A chave
+	// de API e os dados pessoais do cliente são mascarados.
+	Bodies bool
+}
+
+var DefaultLogOptions = LogOptions{
+	Level:      slog.LevelDebug,
+	ErrorLevel: slog.LevelWarn,
+}
+
+// WithLogger registra cada tentativa de requisição em logger.
+func WithLogger(logger *slog.Logger, opts LogOptions) Option {
+	return func(f *Fetch) {
+		f.logger = logger
+		f.log = opts
+	}
+}
+
+// sensitiveFields são os campos mascarados nos corpos registrados, comparados
+// sem diferenciar maiúsculas. "key" cobre a chave Pix dos saques, que pode ser
+// CPF, e-mail ou telefone.
+var sensitiveFields = map[string]bool{
+	"email":         true,
+	"taxid":         true,
+	"cellphone":     true,
+	"key":           true,
+	"apikey":        true,
+	"authorization": true,
+}
+
+func (f *Fetch) logAttempt(
+	ctx context.Context,
+	req *http.Request,
+	reqBody []byte,
+	resp *http.Response,
+	err error,
+	attempt int,
+	duration time.Duration,
+	retrying bool,
+) {
+	if f.logger == nil {
+		return
+	}
+
+	level := f.log.Level
+	if err != nil || resp.StatusCode >= http.StatusBadRequest || retrying {
+		level = f.log.ErrorLevel
+	}
+
+	if !f.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", duration),
+	}
+
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+		if requestID := resp.Header.Get(requestIDHeader); requestID != "" {
+			attrs = append(attrs, slog.String("request_id", requestID))
+		}
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	if retrying {
+		attrs = append(attrs, slog.Bool("retrying", true))
+	}
+
+	if f.log.Bodies {
+		apiKey := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+
+		if reqBody != nil {
+			attrs = append(attrs, slog.String("request_body", redact(reqBody, apiKey)))
+		}
+
+		if resp != nil {
+			attrs = append(attrs, slog.String("response_body", redact(bufferBody(resp), apiKey)))
+		}
+	}
+
+	f.logger.LogAttrs(ctx, level, "abacatepay request", attrs...)
+}
+
+// bufferBody lê o corpo da resposta e o substitui por uma cópia em memória,
+// mantendo-o disponível para ParseResponse.
+func bufferBody(resp *http.Response) []byte {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	var reader io.Reader = bytes.NewReader(body)
+	if err != nil {
+		reader = io.MultiReader(reader, failedReader{err: err})
+	}
+	resp.Body = io.NopCloser(reader)
+
+	return body
+}
+
+type failedReader struct {
+	err error
+}
+
+func (r failedReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// redact mascara os campos sensíveis de um corpo JSON e qualquer ocorrência
+// da chave de API. Corpos que não são JSON só têm a chave mascarada.
+func redact(body []byte, apiKey string) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err == nil {
+		if masked, err := json.Marshal(redactValue(value)); err == nil {
+			body = masked
+		}
+	}
+
+	text := string(body)
+	if apiKey != "" {
+		text = strings.ReplaceAll(text, apiKey, redacted)
+	}
+
+	return text
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,59 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Handler executa uma operação do SDK, incluindo as novas tentativas, usando
+// o contexto de req. Respostas 2xx com o campo error preenchido no envelope
+// são retornadas como *APIError.
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware envolve cada operação do SDK. Diferente de um http.RoundTripper,
+// ele é chamado uma única vez por operação, mesmo quando há novas tentativas,
+// e os cabeçalhos definidos em req são enviados em todas elas.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adiciona middlewares à execução das operações. O primeiro
+// middleware informado é o mais externo.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(f *Fetch) {
+		f.middlewares = append(f.middlewares, middlewares...)
+	}
+}
+
+type operationKey struct{}
+
+// Operation retorna o nome da operação do SDK em andamento no contexto, como
+// "abacatepay.billing.create", ou uma string vazia fora de uma operação.
+func Operation(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
+// operationName deriva o nome da operação do endpoint, por exemplo
+// "/v1/pixQrCode/simulate-payment?id=1" vira
+// "abacatepay.pixqrcode.simulate_payment".
+func operationName(endpoint string) string {
+	path, _, _ := strings.Cut(endpoint, "?")
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 1 && parts[0] == "v1" {
+		parts = parts[1:]
+	}
+
+	name := strings.ToLower(strings.Join(parts, "."))
+	name = strings.ReplaceAll(name, "-", "_")
+
+	return "abacatepay." + name
+}
+
+func (f *Fetch) wrap(handler Handler) Handler {
+	for i := len(f.middlewares) - 1; i >= 0; i-- {
+		handler = f.middlewares[i](handler)
+	}
+
+	return handler
+}
@@ -0,0 +1,70 @@
+package fetch
+
+import (
+	"context"
+	"iter"
+)
+
+type Pagination struct {
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	Total      int    `json:"total"`
+	TotalPages int    `json:"totalPages"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	HasMore    bool   `json:"hasMore"`
+}
+
+// HasNext indica se existe uma próxima página. Respostas sem paginação são
+// tratadas como página única.
+func (p *Pagination) HasNext() bool {
+	if p == nil {
+		return false
+	}
+
+	return p.NextCursor != "" || p.HasMore || p.Page < p.TotalPages
+}
+
+// Paginate retorna um iterador que busca as páginas sob demanda. list busca
+// a página descrita por params e next calcula os parâmetros da página
+// seguinte, retornando false quando a resposta não permite avançar (por
+// exemplo, ao repetir o cursor ou a página atual). Erros, inclusive o
+// cancelamento do contexto, são entregues como o último elemento da iteração.
+func Paginate[P, T any](
+	ctx context.Context,
+	params P,
+	next func(P, *Pagination) (P, bool),
+	list func(context.Context, P) (*Response[[]T], error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		current := params
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := list(ctx, current)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(page.Data) == 0 || !page.Pagination.HasNext() {
+				return
+			}
+
+			var ok bool
+			if current, ok = next(current, page.Pagination); !ok {
+				return
+			}
+		}
+	}
+}
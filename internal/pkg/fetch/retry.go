@@ -0,0 +1,157 @@
+package fetch
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controla as novas tentativas automáticas de uma requisição.
+// Só são repetidas requisições com métodos seguros ou que carregam uma
+// chave de idempotência.
+//
+// BaseDelay, MaxDelay, Jitter e RetryableStatus não informados (zero ou nil)
+// usam os valores da DefaultRetryPolicy, então RetryPolicy{MaxAttempts: 5} só
+// altera o número de tentativas.
+type RetryPolicy struct {
+	// MaxAttempts é o número total de tentativas, incluindo a primeira.
+	// Valores menores ou iguais a 1 desabilitam as novas tentativas.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	// MaxDelay limita a espera entre tentativas. Se a API pedir, via
+	// Retry-After, uma espera maior, a resposta é retornada sem nova tentativa.
+	MaxDelay time.Duration
+	// Jitter é a fração (entre 0 e 1) do atraso que é sorteada aleatoriamente.
+	// Use um valor negativo para desabilitá-lo.
+	Jitter float64
+	// RetryableStatus lista os status repetidos. Use um slice vazio, não nil,
+	// para repetir apenas falhas de rede.
+	RetryableStatus []int
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+	Jitter:      0.2,
+	RetryableStatus: []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = DefaultRetryPolicy.BaseDelay
+	}
+
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultRetryPolicy.MaxDelay
+	}
+
+	if p.Jitter == 0 {
+		p.Jitter = DefaultRetryPolicy.Jitter
+	}
+
+	if p.RetryableStatus == nil {
+		p.RetryableStatus = DefaultRetryPolicy.RetryableStatus
+	}
+
+	return p
+}
+
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+func (p RetryPolicy) canRetry(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+func (p RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	for _, status := range p.RetryableStatus {
+		if resp.StatusCode == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// delay retorna a espera antes da próxima tentativa. O Retry-After da
+// resposta é respeitado integralmente; se ele exceder MaxDelay, delay retorna
+// false e a requisição não deve ser repetida.
+func (p RetryPolicy) delay(attempt int, resp *http.Response) (time.Duration, bool) {
+	delay := p.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+
+	if retryAfter := parseRetryAfter(resp); retryAfter > delay {
+		if retryAfter > p.MaxDelay {
+			return 0, false
+		}
+		delay = retryAfter
+	}
+
+	return delay, true
+}
+
+func parseRetryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
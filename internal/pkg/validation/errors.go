@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type Language string
+
+const (
+	PtBR Language = "pt-BR"
+	EN   Language = "en"
+)
+
+// FieldError descreve a falha de uma regra em um campo.
+type FieldError struct {
+	// Field é o caminho do campo com os nomes do JSON, como products[0].price.
+	Field string
+	// Rule é a regra que falhou, como required ou gte.
+	Rule string
+	// Param é o parâmetro da regra, como 100 em gte=100.
+	Param string
+	kind  reflect.Kind
+}
+
+// ValidationError é retornado pelo Validate de todos os corpos de requisição.
+type ValidationError struct {
+	Fields []FieldError
+	err    error
+}
+
+// NewError cria um ValidationError para regras verificadas fora das tags.
+func NewError(field, rule, param string) *ValidationError {
+	return &ValidationError{
+		Fields: []FieldError{{Field: field, Rule: rule, Param: param}},
+	}
+}
+
+func (e *ValidationError) Error() string {
+	return "validation failed: " + strings.Join(e.Messages(EN), "; ")
+}
+
+// Unwrap expõe o validator.ValidationErrors original, quando houver.
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
+func (e *ValidationError) Messages(lang Language) []string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Message(lang))
+	}
+
+	return messages
+}
+
+type messageSet struct {
+	number, text, list string
+}
+
+var translations = map[Language]map[string]messageSet{
+	EN: {
+		"required":         {number: "%s is required"},
+		"required_without": {number: "%s is required when %s is not provided"},
+		"email":            {number: "%s must be a valid email address"},
+		"url":              {number: "%s must be a valid URL"},
+		"gte":              {number: "%s must be greater than or equal to %s", text: "%s must have at least %s characters", list: "%s must have at least %s items"},
+		"gt":               {number: "%s must be greater than %s", text: "%s must have more than %s characters", list: "%s must have more than %s items"},
+		"lte":              {number: "%s must be less than or equal to %s", text: "%s must have at most %s characters", list: "%s must have at most %s items"},
+		"oneof":            {number: "%s must be one of: %s"},
+		"enum":             {number: "%s has an unsupported value"},
+		"taxid":            {number: "%s must be a valid CPF or CNPJ"},
+		"cellphone":        {number: "%s must be a valid Brazilian mobile number"},
+		"":                 {number: "%s is invalid"},
+	},
+	PtBR: {
+		"required":         {number: "%s é obrigatório"},
+		"required_without": {number: "%s é obrigatório quando %s não é informado"},
+		"email":            {number: "%s deve ser um e-mail válido"},
+		"url":              {number: "%s deve ser uma URL válida"},
+		"gte":              {number: "%s deve ser maior ou igual a %s", text: "%s deve ter no mínimo %s caracteres", list: "%s deve ter no mínimo %s itens"},
+		"gt":               {number: "%s deve ser maior que %s", text: "%s deve ter mais de %s caracteres", list: "%s deve ter mais de %s itens"},
+		"lte":              {number: "%s deve ser menor ou igual a %s", text: "%s deve ter no máximo %s caracteres", list: "%s deve ter no máximo %s itens"},
+		"oneof":            {number: "%s deve ser um dos valores: %s"},
+		"enum":             {number: "%s possui um valor não suportado"},
+		"taxid":            {number: "%s deve ser um CPF ou CNPJ válido"},
+		"cellphone":        {number: "%s deve ser um celular brasileiro válido"},
+		"":                 {number: "%s é inválido"},
+	},
+}
+
+var ruleAliases = map[string]string{
+	"min": "gte",
+	"max": "lte",
+}
+
+// Message traduz a falha para o idioma informado. Idiomas desconhecidos
+// usam o inglês.
+func (f FieldError) Message(lang Language) string {
+	messages, ok := translations[lang]
+	if !ok {
+		messages = translations[EN]
+	}
+
+	rule := f.Rule
+	if alias, ok := ruleAliases[rule]; ok {
+		rule = alias
+	}
+
+	set, ok := messages[rule]
+	if !ok {
+		set = messages[""]
+	}
+
+	format := set.number
+	switch f.kind {
+	case reflect.String:
+		if set.text != "" {
+			format = set.text
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if set.list != "" {
+			format = set.list
+		}
+	}
+
+	if strings.Count(format, "%s") == 2 {
+		return fmt.Sprintf(format, f.Field, f.Param)
+	}
+
+	return fmt.Sprintf(format, f.Field)
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/phone"
+	"github.com/AbacatePay/abacatepay-go-sdk/taxid"
+)
+
+// Validator valida os corpos de requisição e converte as falhas em
+// *ValidationError.
+type Validator struct {
+	validate *validator.Validate
+}
+
+// New cria um validador com as tags customizadas usadas pelos corpos de
+// requisição do SDK:
+//
+//   - enum: o tipo do campo implementa IsValid() bool
+//   - taxid: CPF ou CNPJ com dígitos verificadores válidos
+//   - cellphone: celular brasileiro com DDD válido
+//
+// Os campos são identificados pelo nome usado no JSON.
+func New() *Validator {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(jsonName)
+	validate.RegisterValidation("enum", validateEnum)
+	validate.RegisterValidation("taxid", validateTaxID)
+	validate.RegisterValidation("cellphone", validateCellphone)
+
+	return &Validator{validate: validate}
+}
+
+func (v *Validator) Struct(s interface{}) error {
+	err := v.validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	var invalid *validator.InvalidValidationError
+	if errors.As(err, &invalid) {
+		return NewError("body", "required", "")
+	}
+
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		return err
+	}
+
+	validationErr := &ValidationError{err: fieldErrors}
+	for _, fe := range fieldErrors {
+		validationErr.Fields = append(validationErr.Fields, FieldError{
+			Field: fieldPath(fe.Namespace()),
+			Rule:  fe.Tag(),
+			Param: fe.Param(),
+			kind:  fe.Kind(),
+		})
+	}
+
+	return validationErr
+}
+
+func jsonName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
+
+// fieldPath remove o nome da struct raiz do namespace do validator, por
+// exemplo "CreateBillingBody.products[0].price" vira "products[0].price".
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+
+	return namespace
+}
+
+func validateEnum(fl validator.FieldLevel) bool {
+	value, ok := fl.Field().Interface().(interface{ IsValid() bool })
+	return ok && value.IsValid()
+}
+
+func validateTaxID(fl validator.FieldLevel) bool {
+	return taxid.IsValid(fl.Field().String())
+}
+
+func validateCellphone(fl validator.FieldLevel) bool {
+	return phone.IsValid(fl.Field().String())
+}
@@ -0,0 +1,71 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
+)
+
+type item struct {
+	Price int `json:"price" validate:"gte=100"`
+}
+
+type body struct {
+	Name  string   `json:"name"  validate:"max=3"`
+	Tags  []string `json:"tags"  validate:"max=1"`
+	Items []*item  `json:"items" validate:"required,dive"`
+}
+
+func TestStruct(t *testing.T) {
+	validate := validation.New()
+
+	t.Run("Should return nil for a valid struct", func(t *testing.T) {
+		assert.NoError(t, validate.Struct(&body{Name: "abc", Items: []*item{{Price: 100}}}))
+	})
+
+	t.Run("Should describe every failing field", func(t *testing.T) {
+		err := validate.Struct(&body{
+			Name:  "abcd",
+			Tags:  []string{"a", "b"},
+			Items: []*item{{Price: 100}, {Price: 10}},
+		})
+
+		var validationErr *validation.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			"name must have at most 3 characters",
+			"tags must have at most 1 items",
+			"items[1].price must be greater than or equal to 100",
+		}, validationErr.Messages(validation.EN))
+		assert.Equal(t, []string{
+			"name deve ter no máximo 3 caracteres",
+			"tags deve ter no máximo 1 itens",
+			"items[1].price deve ser maior ou igual a 100",
+		}, validationErr.Messages(validation.PtBR))
+	})
+
+	t.Run("Should unwrap to validator errors", func(t *testing.T) {
+		err := validate.Struct(&body{})
+
+		var fieldErrors validator.ValidationErrors
+		assert.True(t, errors.As(err, &fieldErrors))
+	})
+
+	t.Run("Should reject a nil body", func(t *testing.T) {
+		var validationErr *validation.ValidationError
+		assert.ErrorAs(t, validate.Struct((*body)(nil)), &validationErr)
+		assert.Equal(t, "body is required", validationErr.Fields[0].Message(validation.EN))
+	})
+}
+
+func TestNewError(t *testing.T) {
+	t.Run("Should fall back to English for unknown languages", func(t *testing.T) {
+		err := validation.NewError("customer.email", "required_without", "customerId")
+
+		assert.Equal(t, []string{"customer.email is required when customerId is not provided"}, err.Messages("es"))
+	})
+}
@@ -0,0 +1,244 @@
+// Package money representa valores em reais (BRL) como um número inteiro de
+// centavos, que é o formato usado por todos os valores da API.
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidAmount      = errors.New("invalid amount")
+	ErrFractionalCentavos = errors.New("amount has fractional centavos")
+	ErrOverflow           = errors.New("amount overflow")
+)
+
+// Money é um valor em centavos de real.
+type Money int64
+
+func FromCents(cents int64) Money {
+	return Money(cents)
+}
+
+// FromReais converte um valor inteiro em reais.
+func FromReais(reais int64) (Money, error) {
+	return Money(reais).Mul(100)
+}
+
+// FromFloat converte um valor em reais com casas decimais, como 12.5. Valores
+// com frações de centavo retornam ErrFractionalCentavos.
+func FromFloat(reais float64) (Money, error) {
+	if math.IsNaN(reais) || math.IsInf(reais, 0) {
+		return 0, ErrInvalidAmount
+	}
+
+	cents := reais * 100
+	rounded := math.Round(cents)
+	if math.Abs(cents-rounded) > 1e-6 {
+		return 0, ErrFractionalCentavos
+	}
+
+	if rounded > math.MaxInt64 || rounded < math.MinInt64 {
+		return 0, ErrOverflow
+	}
+
+	return Money(rounded), nil
+}
+
+// Parse converte textos como "1234,56", "1.234,56", "R$ 1.234,56" ou
+// "1234.56". Quando há apenas pontos, vários pontos são separadores de milhar;
+// um único ponto é decimal, exceto no formato de milhar pt-BR, em que "1.234"
+// é lido como R$ 1.234,00.
+func Parse(s string) (Money, error) {
+	value := strings.TrimSpace(s)
+
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+	value = strings.TrimSpace(strings.TrimPrefix(value, "R$"))
+	value = strings.ReplaceAll(value, " ", "")
+
+	if value == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	integer, fraction := splitDecimal(value)
+	for _, part := range []string{integer, fraction} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+			}
+		}
+	}
+
+	if integer == "" {
+		integer = "0"
+	}
+
+	if len(strings.TrimRight(fraction, "0")) > 2 {
+		return 0, fmt.Errorf("%w: %q", ErrFractionalCentavos, s)
+	}
+
+	fraction = (fraction + "00")[:2]
+
+	reais, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrOverflow, s)
+	}
+
+	cents, _ := strconv.ParseInt(fraction, 10, 64)
+
+	m, err := Money(reais).Mul(100)
+	if err != nil {
+		return 0, err
+	}
+
+	m, err = m.Add(Money(cents))
+	if err != nil {
+		return 0, err
+	}
+
+	if negative {
+		return -m, nil
+	}
+
+	return m, nil
+}
+
+// splitDecimal separa a parte inteira da decimal. A vírgula é sempre decimal
+// quando é o último separador; um único ponto também é, exceto quando segue o
+// formato de milhar pt-BR ("1.234"), caso em que é tratado como separador de
+// milhar.
+func splitDecimal(value string) (string, string) {
+	lastDot := strings.LastIndex(value, ".")
+	lastComma := strings.LastIndex(value, ",")
+
+	separator := -1
+	switch {
+	case lastComma > lastDot:
+		separator = lastComma
+	case lastDot > lastComma && strings.Count(value, ".") == 1 && !isThousandsGroup(value, lastDot):
+		separator = lastDot
+	}
+
+	if separator < 0 {
+		return removeSeparators(value), ""
+	}
+
+	return removeSeparators(value[:separator]), value[separator+1:]
+}
+
+// isThousandsGroup indica se o ponto em dot separa de 1 a 3 dígitos iniciais,
+// sem zero à esquerda, de um grupo de exatamente 3 dígitos, como em "1.234".
+func isThousandsGroup(value string, dot int) bool {
+	return dot >= 1 && dot <= 3 && value[0] != '0' && len(value)-dot-1 == 3
+}
+
+func removeSeparators(value string) string {
+	return strings.NewReplacer(".", "", ",", "").Replace(value)
+}
+
+func (m Money) Cents() int64 {
+	return int64(m)
+}
+
+func (m Money) Reais() float64 {
+	return float64(m) / 100
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	result := m + other
+	if (other > 0 && result < m) || (other < 0 && result > m) {
+		return 0, ErrOverflow
+	}
+
+	return result, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if other == math.MinInt64 {
+		return 0, ErrOverflow
+	}
+
+	return m.Add(-other)
+}
+
+func (m Money) Mul(n int64) (Money, error) {
+	if m == 0 || n == 0 {
+		return 0, nil
+	}
+
+	result := m * Money(n)
+	if result/Money(n) != m || (m == -1 && n == math.MinInt64) || (n == -1 && m == math.MinInt64) {
+		return 0, ErrOverflow
+	}
+
+	return result, nil
+}
+
+// String formata o valor no padrão pt-BR, por exemplo "R$ 1.234,56".
+func (m Money) String() string {
+	cents := int64(m)
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+	}
+
+	abs := uint64(cents)
+	if cents < 0 {
+		abs = uint64(-(cents + 1)) + 1
+	}
+
+	integer := strconv.FormatUint(abs/100, 10)
+
+	var grouped strings.Builder
+	for i, r := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(r)
+	}
+
+	return fmt.Sprintf("%sR$ %s,%02d", sign, grouped.String(), abs%100)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(m), 10)), nil
+}
+
+// UnmarshalJSON aceita apenas números inteiros de centavos.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var number *json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidAmount, data)
+	}
+
+	if number == nil {
+		return nil
+	}
+
+	cents, err := strconv.ParseInt(number.String(), 10, 64)
+	if err != nil {
+		value, floatErr := number.Float64()
+		if floatErr != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidAmount, data)
+		}
+
+		if value != math.Trunc(value) {
+			return fmt.Errorf("%w: %s", ErrFractionalCentavos, data)
+		}
+
+		if value >= math.MaxInt64 || value < math.MinInt64 {
+			return fmt.Errorf("%w: %s", ErrOverflow, data)
+		}
+
+		cents = int64(value)
+	}
+
+	*m = Money(cents)
+
+	return nil
+}
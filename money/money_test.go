@@ -0,0 +1,150 @@
+package money_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+)
+
+func TestConstructors(t *testing.T) {
+	t.Run("Create from reais", func(t *testing.T) {
+		m, err := money.FromReais(12)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1200), m.Cents())
+	})
+
+	t.Run("Create from float", func(t *testing.T) {
+		m, err := money.FromFloat(19.99)
+		assert.NoError(t, err)
+		assert.Equal(t, money.FromCents(1999), m)
+	})
+
+	t.Run("Error on float with fractional centavos", func(t *testing.T) {
+		_, err := money.FromFloat(1.234)
+		assert.ErrorIs(t, err, money.ErrFractionalCentavos)
+	})
+}
+
+func TestParse(t *testing.T) {
+	cases := map[string]int64{
+		"1234,56":      123456,
+		"1.234,56":     123456,
+		"R$ 1.234,56":  123456,
+		"-R$ 10,00":    -1000,
+		"1234.56":      123456,
+		"1,234.56":     123456,
+		"1.234.567":    123456700,
+		"12,5":         1250,
+		"0,01":         1,
+		"100":          10000,
+		"R$ 1.000.000": 100000000,
+		"  R$ 3,10  ":  310,
+		"2,500":        250,
+		"R$1.234,5":    123450,
+		"R$ 1.234":     123400,
+		"1.234":        123400,
+		"-R$ 10.000":   -1000000,
+		"0.500":        50,
+		"1.2":          120,
+	}
+
+	for input, expected := range cases {
+		t.Run(input, func(t *testing.T) {
+			m, err := money.Parse(input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, m.Cents())
+		})
+	}
+
+	t.Run("Error on invalid input", func(t *testing.T) {
+		for _, input := range []string{"", "R$", "abc", "12,3a"} {
+			_, err := money.Parse(input)
+			assert.ErrorIs(t, err, money.ErrInvalidAmount, input)
+		}
+	})
+
+	t.Run("Error on fractional centavos", func(t *testing.T) {
+		for _, input := range []string{"1,234", "1234.567", "0.001"} {
+			_, err := money.Parse(input)
+			assert.ErrorIs(t, err, money.ErrFractionalCentavos, input)
+		}
+	})
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Run("Add, subtract and multiply", func(t *testing.T) {
+		m, err := money.FromCents(1000).Add(250)
+		assert.NoError(t, err)
+		assert.Equal(t, money.Money(1250), m)
+
+		m, err = m.Sub(2000)
+		assert.NoError(t, err)
+		assert.Equal(t, money.Money(-750), m)
+
+		m, err = m.Mul(-2)
+		assert.NoError(t, err)
+		assert.Equal(t, money.Money(1500), m)
+	})
+
+	t.Run("Error on overflow", func(t *testing.T) {
+		_, err := money.Money(math.MaxInt64).Add(1)
+		assert.ErrorIs(t, err, money.ErrOverflow)
+
+		_, err = money.Money(math.MinInt64).Sub(1)
+		assert.ErrorIs(t, err, money.ErrOverflow)
+
+		_, err = money.Money(math.MaxInt64 / 2).Mul(3)
+		assert.ErrorIs(t, err, money.ErrOverflow)
+
+		_, err = money.Money(math.MinInt64).Mul(-1)
+		assert.ErrorIs(t, err, money.ErrOverflow)
+	})
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "R$ 0,00", money.Money(0).String())
+	assert.Equal(t, "R$ 0,05", money.Money(5).String())
+	assert.Equal(t, "R$ 1.234,56", money.Money(123456).String())
+	assert.Equal(t, "R$ 1.000.000,00", money.Money(100000000).String())
+	assert.Equal(t, "-R$ 12,30", money.Money(-1230).String())
+	assert.Equal(t, "-R$ 92.233.720.368.547.758,08", money.Money(math.MinInt64).String())
+}
+
+func TestJSON(t *testing.T) {
+	type payload struct {
+		Amount money.Money `json:"amount"`
+	}
+
+	t.Run("Round trip as integer cents", func(t *testing.T) {
+		data, err := json.Marshal(payload{Amount: 123456})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"amount": 123456}`, string(data))
+
+		var decoded payload
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, money.Money(123456), decoded.Amount)
+	})
+
+	t.Run("Accept integral numbers written with decimals", func(t *testing.T) {
+		var decoded payload
+		assert.NoError(t, json.Unmarshal([]byte(`{"amount": 100.0}`), &decoded))
+		assert.Equal(t, money.Money(100), decoded.Amount)
+	})
+
+	t.Run("Error on fractional centavos", func(t *testing.T) {
+		var decoded payload
+		err := json.Unmarshal([]byte(`{"amount": 100.5}`), &decoded)
+		assert.ErrorIs(t, err, money.ErrFractionalCentavos)
+	})
+
+	t.Run("Error on non numeric values", func(t *testing.T) {
+		var decoded payload
+		err := json.Unmarshal([]byte(`{"amount": true}`), &decoded)
+		assert.ErrorIs(t, err, money.ErrInvalidAmount)
+	})
+}
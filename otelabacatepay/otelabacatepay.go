@@ -0,0 +1,209 @@
+// Package otelabacatepay instrumenta o cliente do SDK da AbacatePay com
+// OpenTelemetry. Cada operação do SDK, como billing.Create, gera um span do
+// tipo client chamado "abacatepay.billing.create", propaga o contexto de
+// rastreamento nos cabeçalhos e registra a duração e os erros das operações.
+//
+//	client, err := abacatepay.New(&abacatepay.ClientConfig{
+//		ApiKey:     os.Getenv("ABACATEPAY_API_KEY"),
+//		Middleware: []abacatepay.Middleware{otelabacatepay.Middleware()},
+//	})
+package otelabacatepay
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/abacatepay"
+)
+
+const ScopeName = "github.com/AbacatePay/abacatepay-go-sdk/otelabacatepay"
+
+// OperationKey identifica a operação do SDK nos spans e métricas.
+const OperationKey = attribute.Key("abacatepay.operation")
+
+// ErrorTypeAPI é o error.type das respostas 2xx cujo envelope traz o campo
+// error preenchido.
+const ErrorTypeAPI = "api_error"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagators    propagation.TextMapPropagator
+}
+
+type Option func(*config)
+
+// WithTracerProvider substitui o provider global de traces.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		if provider != nil {
+			c.tracerProvider = provider
+		}
+	}
+}
+
+// WithMeterProvider substitui o provider global de métricas.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		if provider != nil {
+			c.meterProvider = provider
+		}
+	}
+}
+
+// WithPropagators substitui os propagadores globais usados para injetar o
+// contexto de rastreamento nas requisições.
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		if propagators != nil {
+			c.propagators = propagators
+		}
+	}
+}
+
+// Middleware retorna um abacatepay.Middleware que instrumenta cada operação
+// do SDK. O span cobre todas as tentativas da operação.
+//
+// As métricas registradas são:
+//
+//   - abacatepay.client.operation.duration: duração das operações, em segundos
+//   - abacatepay.client.operation.errors: operações com erro de rede, status
+//     4xx/5xx ou envelope com o campo error preenchido
+func Middleware(opts ...Option) abacatepay.Middleware {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagators:    otel.GetTextMapPropagator(),
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(ScopeName)
+	meter := cfg.meterProvider.Meter(ScopeName)
+
+	duration, err := meter.Float64Histogram(
+		"abacatepay.client.operation.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of AbacatePay SDK operations, including retries."),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	errorCount, err := meter.Int64Counter(
+		"abacatepay.client.operation.errors",
+		metric.WithUnit("{error}"),
+		metric.WithDescription("Number of AbacatePay SDK operations that failed."),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return func(next abacatepay.Handler) abacatepay.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			operation := abacatepay.Operation(req.Context())
+			attrs := []attribute.KeyValue{
+				OperationKey.String(operation),
+				semconv.HTTPRequestMethodKey.String(req.Method),
+			}
+
+			ctx, span := tracer.Start(
+				req.Context(),
+				operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+				trace.WithAttributes(requestAttributes(req)...),
+			)
+			defer span.End()
+
+			req = req.WithContext(ctx)
+			cfg.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			start := time.Now()
+			resp, err := next(req)
+			elapsed := time.Since(start)
+
+			errorType := ""
+			var apiErr *abacatepay.APIError
+			switch {
+			case errors.As(err, &apiErr):
+				// Resposta 2xx com o campo error preenchido no envelope.
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(apiErr.StatusCode))
+				span.SetAttributes(semconv.HTTPResponseStatusCode(apiErr.StatusCode))
+				errorType = ErrorTypeAPI
+				span.SetStatus(codes.Error, apiErr.Message)
+			case err != nil:
+				errorType = requestErrorType(err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			default:
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(resp.StatusCode))
+				span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+
+				if resp.StatusCode >= http.StatusBadRequest {
+					errorType = strconv.Itoa(resp.StatusCode)
+					span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+				}
+			}
+
+			if errorType != "" {
+				attrs = append(attrs, semconv.ErrorTypeKey.String(errorType))
+				span.SetAttributes(semconv.ErrorTypeKey.String(errorType))
+				errorCount.Add(ctx, 1, metric.WithAttributes(attrs...))
+			}
+
+			duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+
+			return resp, err
+		}
+	}
+}
+
+func requestAttributes(req *http.Request) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		semconv.URLFull(req.URL.String()),
+	}
+
+	host, port, err := net.SplitHostPort(req.URL.Host)
+	if err != nil {
+		host = req.URL.Host
+		port = ""
+	}
+
+	attrs = append(attrs, semconv.ServerAddress(host))
+
+	if p, err := strconv.Atoi(port); err == nil {
+		attrs = append(attrs, semconv.ServerPort(p))
+	} else if req.URL.Scheme == "https" {
+		attrs = append(attrs, semconv.ServerPort(443))
+	} else if req.URL.Scheme == "http" {
+		attrs = append(attrs, semconv.ServerPort(80))
+	}
+
+	return attrs
+}
+
+func requestErrorType(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	}
+
+	return semconv.ErrorTypeOther.Value.AsString()
+}
@@ -0,0 +1,171 @@
+package otelabacatepay_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/abacatepay"
+	"github.com/AbacatePay/abacatepay-go-sdk/otelabacatepay"
+)
+
+type telemetry struct {
+	spans  *tracetest.SpanRecorder
+	reader *sdkmetric.ManualReader
+	client *abacatepay.Client
+}
+
+func newTelemetry(t *testing.T, url string) *telemetry {
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client, err := abacatepay.New(&abacatepay.ClientConfig{
+		Url:    url,
+		ApiKey: "test-key",
+		Retry:  &abacatepay.RetryPolicy{MaxAttempts: 1},
+		Middleware: []abacatepay.Middleware{otelabacatepay.Middleware(
+			otelabacatepay.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+			otelabacatepay.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+			otelabacatepay.WithPropagators(propagation.TraceContext{}),
+		)},
+	})
+	assert.NoError(t, err)
+
+	return &telemetry{spans: spans, reader: reader, client: client}
+}
+
+func (tel *telemetry) metrics(t *testing.T) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, tel.reader.Collect(context.Background(), &rm))
+
+	metrics := map[string]metricdata.Aggregation{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	return metrics
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+
+	return attrs
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Should create a client span per operation and propagate context", func(t *testing.T) {
+		var traceparent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceparent = r.Header.Get("traceparent")
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"id": "store_1234"}})
+		}))
+		defer server.Close()
+
+		tel := newTelemetry(t, server.URL)
+
+		_, err := tel.client.Store.Get(context.Background())
+		assert.NoError(t, err)
+
+		spans := tel.spans.Ended()
+		assert.Len(t, spans, 1)
+
+		span := spans[0]
+		assert.Equal(t, "abacatepay.store.get", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+		assert.Equal(t, codes.Unset, span.Status().Code)
+
+		attrs := attributes(span)
+		assert.Equal(t, "GET", attrs["http.request.method"].AsString())
+		assert.Equal(t, int64(200), attrs["http.response.status_code"].AsInt64())
+		assert.Equal(t, server.URL+"/v1/store/get", attrs["url.full"].AsString())
+		assert.Equal(t, "127.0.0.1", attrs["server.address"].AsString())
+		assert.Equal(t, "abacatepay.store.get", attrs["abacatepay.operation"].AsString())
+
+		assert.Contains(t, traceparent, span.SpanContext().TraceID().String())
+		assert.Contains(t, traceparent, span.SpanContext().SpanID().String())
+
+		metrics := tel.metrics(t)
+		duration := metrics["abacatepay.client.operation.duration"].(metricdata.Histogram[float64])
+		assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+		assert.NotContains(t, metrics, "abacatepay.client.operation.errors")
+	})
+
+	t.Run("Should record failed operations", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"data": null, "error": "Store not found"}`))
+		}))
+		defer server.Close()
+
+		tel := newTelemetry(t, server.URL)
+
+		_, err := tel.client.Store.Get(context.Background())
+		assert.True(t, abacatepay.IsNotFound(err))
+
+		span := tel.spans.Ended()[0]
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, "404", attributes(span)["error.type"].AsString())
+
+		errorCount := tel.metrics(t)["abacatepay.client.operation.errors"].(metricdata.Sum[int64])
+		assert.Equal(t, int64(1), errorCount.DataPoints[0].Value)
+
+		value, ok := errorCount.DataPoints[0].Attributes.Value("abacatepay.operation")
+		assert.True(t, ok)
+		assert.Equal(t, "abacatepay.store.get", value.AsString())
+	})
+
+	t.Run("Should record error envelopes in 2xx responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data": null, "error": "Store not found"}`))
+		}))
+		defer server.Close()
+
+		tel := newTelemetry(t, server.URL)
+
+		_, err := tel.client.Store.Get(context.Background())
+
+		var apiErr *abacatepay.APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "Store not found", apiErr.Message)
+
+		span := tel.spans.Ended()[0]
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, "Store not found", span.Status().Description)
+		assert.Equal(t, otelabacatepay.ErrorTypeAPI, attributes(span)["error.type"].AsString())
+
+		errorCount := tel.metrics(t)["abacatepay.client.operation.errors"].(metricdata.Sum[int64])
+		assert.Equal(t, int64(1), errorCount.DataPoints[0].Value)
+	})
+
+	t.Run("Should record request errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		tel := newTelemetry(t, server.URL)
+
+		_, err := tel.client.Store.Get(context.Background())
+		assert.Error(t, err)
+
+		span := tel.spans.Ended()[0]
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, "_OTHER", attributes(span)["error.type"].AsString())
+		assert.NotEmpty(t, span.Events())
+	})
+}
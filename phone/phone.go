@@ -0,0 +1,74 @@
+// Package phone valida e normaliza números de celular brasileiros para o
+// formato E.164 (+55 DDD 9XXXX-XXXX).
+package phone
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidPhone = errors.New("invalid phone number")
+	ErrInvalidDDD   = errors.New("invalid DDD")
+	ErrNotMobile    = errors.New("phone number is not a mobile number")
+)
+
+var validDDDs = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true, "27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+// Normalize converte números como "(11) 9 8765-4321", "11987654321",
+// "011 98765-4321" ou "+55 11 98765-4321" para "+5511987654321".
+func Normalize(s string) (string, error) {
+	value := strings.TrimSpace(s)
+	international := strings.HasPrefix(value, "+")
+
+	var digits strings.Builder
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case strings.ContainsRune(" ()-.+", r):
+		default:
+			return "", ErrInvalidPhone
+		}
+	}
+
+	number := digits.String()
+	switch {
+	case international || (len(number) == 13 && strings.HasPrefix(number, "55")):
+		if !strings.HasPrefix(number, "55") {
+			return "", ErrInvalidPhone
+		}
+		number = number[2:]
+	case len(number) == 12 && strings.HasPrefix(number, "0"):
+		number = number[1:]
+	}
+
+	if len(number) != 11 {
+		return "", ErrInvalidPhone
+	}
+
+	if !validDDDs[number[:2]] {
+		return "", ErrInvalidDDD
+	}
+
+	if number[2] != '9' {
+		return "", ErrNotMobile
+	}
+
+	return "+55" + number, nil
+}
+
+func IsValid(s string) bool {
+	_, err := Normalize(s)
+	return err == nil
+}
@@ -0,0 +1,51 @@
+package phone_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/phone"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("Normalize common formats to E.164", func(t *testing.T) {
+		for _, value := range []string{
+			"(11) 9 8765-4321",
+			"(11) 98765-4321",
+			"11987654321",
+			"011 98765-4321",
+			"+55 11 98765-4321",
+			"+55 (11) 9.8765-4321",
+			"5511987654321",
+		} {
+			normalized, err := phone.Normalize(value)
+			assert.NoError(t, err, value)
+			assert.Equal(t, "+5511987654321", normalized, value)
+		}
+	})
+
+	t.Run("Reject invalid DDD", func(t *testing.T) {
+		_, err := phone.Normalize("(10) 98765-4321")
+		assert.ErrorIs(t, err, phone.ErrInvalidDDD)
+
+		_, err = phone.Normalize("(23) 98765-4321")
+		assert.ErrorIs(t, err, phone.ErrInvalidDDD)
+	})
+
+	t.Run("Reject landlines", func(t *testing.T) {
+		_, err := phone.Normalize("(11) 4002-8922")
+		assert.ErrorIs(t, err, phone.ErrInvalidPhone)
+
+		_, err = phone.Normalize("(11) 3 4002-8922")
+		assert.ErrorIs(t, err, phone.ErrNotMobile)
+	})
+
+	t.Run("Reject malformed numbers", func(t *testing.T) {
+		for _, value := range []string{"", "abc", "+1 415 555 2671", "9876-4321", "(11) 98765-4321 ramal 2"} {
+			_, err := phone.Normalize(value)
+			assert.ErrorIs(t, err, phone.ErrInvalidPhone, value)
+			assert.False(t, phone.IsValid(value), value)
+		}
+	})
+}
@@ -0,0 +1,158 @@
+// Package taxid valida, normaliza e formata documentos brasileiros (CPF e
+// CNPJ), incluindo o CNPJ alfanumérico.
+package taxid
+
+import (
+	"errors"
+	"strings"
+)
+
+type Kind string
+
+const (
+	CPF  Kind = "CPF"
+	CNPJ Kind = "CNPJ"
+)
+
+var (
+	ErrInvalidTaxID = errors.New("invalid tax ID")
+	ErrInvalidCPF   = errors.New("invalid CPF")
+	ErrInvalidCNPJ  = errors.New("invalid CNPJ")
+)
+
+// Normalize remove a pontuação e converte letras para maiúsculas.
+func Normalize(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(s) {
+		if (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// Parse normaliza o documento, identifica se é CPF ou CNPJ e valida os
+// dígitos verificadores.
+func Parse(s string) (string, Kind, error) {
+	value := Normalize(s)
+
+	switch len(value) {
+	case 11:
+		if !validCPF(value) {
+			return "", "", ErrInvalidCPF
+		}
+		return value, CPF, nil
+	case 14:
+		if !validCNPJ(value) {
+			return "", "", ErrInvalidCNPJ
+		}
+		return value, CNPJ, nil
+	}
+
+	return "", "", ErrInvalidTaxID
+}
+
+func Validate(s string) error {
+	_, _, err := Parse(s)
+	return err
+}
+
+func IsValid(s string) bool {
+	return Validate(s) == nil
+}
+
+func IsCPF(s string) bool {
+	_, kind, err := Parse(s)
+	return err == nil && kind == CPF
+}
+
+func IsCNPJ(s string) bool {
+	_, kind, err := Parse(s)
+	return err == nil && kind == CNPJ
+}
+
+// Format aplica a máscara do documento: 000.000.000-00 para CPF e
+// 00.000.000/0000-00 para CNPJ.
+func Format(s string) (string, error) {
+	value, kind, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	if kind == CPF {
+		return value[0:3] + "." + value[3:6] + "." + value[6:9] + "-" + value[9:11], nil
+	}
+
+	return value[0:2] + "." + value[2:5] + "." + value[5:8] + "/" + value[8:12] + "-" + value[12:14], nil
+}
+
+func validCPF(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	if allEqual(value) {
+		return false
+	}
+
+	return checkDigit(value[:9], 10) == value[9] && checkDigit(value[:10], 11) == value[10]
+}
+
+func validCNPJ(value string) bool {
+	for i, r := range value {
+		isDigit := r >= '0' && r <= '9'
+		if !isDigit && (i >= 12 || r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+
+	if allEqual(value) {
+		return false
+	}
+
+	return cnpjCheckDigit(value[:12]) == value[12] && cnpjCheckDigit(value[:13]) == value[13]
+}
+
+// checkDigit calcula o dígito verificador do CPF com pesos decrescentes a
+// partir de weight.
+func checkDigit(value string, weight int) byte {
+	sum := 0
+	for i := 0; i < len(value); i++ {
+		sum += int(value[i]-'0') * (weight - i)
+	}
+
+	return mod11(sum)
+}
+
+// cnpjCheckDigit calcula o dígito verificador do CNPJ. Cada caractere vale o
+// seu código ASCII menos 48, o que mantém o cálculo dos CNPJs numéricos e
+// permite os alfanuméricos.
+func cnpjCheckDigit(value string) byte {
+	sum := 0
+	weight := len(value) - 7
+	for i := 0; i < len(value); i++ {
+		sum += int(value[i]-'0') * weight
+		weight--
+		if weight < 2 {
+			weight = 9
+		}
+	}
+
+	return mod11(sum)
+}
+
+func mod11(sum int) byte {
+	rest := sum % 11
+	if rest < 2 {
+		return '0'
+	}
+
+	return byte('0' + 11 - rest)
+}
+
+func allEqual(value string) bool {
+	return strings.Count(value, value[:1]) == len(value)
+}
@@ -0,0 +1,63 @@
+package taxid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/taxid"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("Accept valid CPFs", func(t *testing.T) {
+		for _, value := range []string{"529.982.247-25", "52998224725", " 111.444.777-35 "} {
+			assert.NoError(t, taxid.Validate(value), value)
+			assert.True(t, taxid.IsCPF(value), value)
+		}
+	})
+
+	t.Run("Accept valid CNPJs", func(t *testing.T) {
+		for _, value := range []string{"11.222.333/0001-81", "11222333000181", "12.ABC.345/01DE-35", "12abc34501de35"} {
+			assert.NoError(t, taxid.Validate(value), value)
+			assert.True(t, taxid.IsCNPJ(value), value)
+		}
+	})
+
+	t.Run("Reject invalid CPFs", func(t *testing.T) {
+		for _, value := range []string{"529.982.247-24", "111.111.111-11", "5299822472A"} {
+			assert.ErrorIs(t, taxid.Validate(value), taxid.ErrInvalidCPF, value)
+		}
+	})
+
+	t.Run("Reject invalid CNPJs", func(t *testing.T) {
+		for _, value := range []string{"11.222.333/0001-82", "00.000.000/0000-00", "12.ABC.345/01DE-3A", "12.ABC.345/01DE-36"} {
+			assert.ErrorIs(t, taxid.Validate(value), taxid.ErrInvalidCNPJ, value)
+		}
+	})
+
+	t.Run("Reject values with wrong length", func(t *testing.T) {
+		for _, value := range []string{"", "123", "529.982.247-2"} {
+			assert.ErrorIs(t, taxid.Validate(value), taxid.ErrInvalidTaxID, value)
+		}
+	})
+}
+
+func TestFormat(t *testing.T) {
+	t.Run("Mask CPF and CNPJ", func(t *testing.T) {
+		cpf, err := taxid.Format("52998224725")
+		assert.NoError(t, err)
+		assert.Equal(t, "529.982.247-25", cpf)
+
+		cnpj, err := taxid.Format("11222333000181")
+		assert.NoError(t, err)
+		assert.Equal(t, "11.222.333/0001-81", cnpj)
+
+		alphanumeric, err := taxid.Format("12abc34501de35")
+		assert.NoError(t, err)
+		assert.Equal(t, "12.ABC.345/01DE-35", alphanumeric)
+	})
+
+	t.Run("Normalize removes punctuation", func(t *testing.T) {
+		assert.Equal(t, "12ABC34501DE35", taxid.Normalize("12.abc.345/01de-35"))
+	})
+}
@@ -2,7 +2,7 @@ package billing
 
 import (
 	"context"
-	"fmt"
+	"iter"
 
 	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
 )
@@ -20,18 +20,18 @@ func New(httpClient *fetch.Fetch) *Billing {
 func (b *Billing) Create(
 	ctx context.Context,
 	body *CreateBillingBody,
+	opts ...fetch.RequestOptions,
 ) (*CreateBillingResponse, error) {
 	if err := body.Validate(); err != nil {
 		return nil, err
 	}
 
-	if body.CustomerId == "" && (body.Customer == nil || body.Customer.Email == "") {
-		return nil, fmt.Errorf("customerId or customer.email is required")
-	}
+	normalized := *body
+	normalized.Customer = body.Customer.Normalize()
 
 	var response CreateBillingResponse
 
-	resp, err := b.HttpClient.Post(ctx, "/v1/billing/create", body)
+	resp, err := b.HttpClient.Post(ctx, "/v1/billing/create", &normalized, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,10 +44,21 @@ func (b *Billing) Create(
 	return &response, nil
 }
 
-func (b *Billing) ListAll(ctx context.Context) (*ListBillingResponse, error) {
+// List retorna uma página de cobranças filtradas por params. Use o campo
+// Pagination da resposta para buscar as próximas páginas.
+func (b *Billing) List(
+	ctx context.Context,
+	params *ListBillingParams,
+	opts ...fetch.RequestOptions,
+) (*ListBillingResponse, error) {
+	endpoint := "/v1/billing/list"
+	if query := params.query().Encode(); query != "" {
+		endpoint += "?" + query
+	}
+
 	var response ListBillingResponse
 
-	resp, err := b.HttpClient.Get(ctx, "/v1/billing/list")
+	resp, err := b.HttpClient.Get(ctx, endpoint, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,3 +70,75 @@ func (b *Billing) ListAll(ctx context.Context) (*ListBillingResponse, error) {
 
 	return &response, nil
 }
+
+// All retorna um iterador sobre todas as cobranças que atendem a params,
+// buscando as páginas sob demanda. Interromper o laço evita buscar as
+// páginas restantes.
+//
+// Respostas sem o bloco pagination encerram a iteração. Com params nil, nenhum
+// limit é enviado, mantendo o tamanho de página padrão da API.
+func (b *Billing) All(
+	ctx context.Context,
+	params *ListBillingParams,
+	opts ...fetch.RequestOptions,
+) iter.Seq2[BillingItem, error] {
+	if params == nil {
+		params = &ListBillingParams{}
+	}
+
+	list := func(ctx context.Context, params *ListBillingParams) (*ListBillingResponse, error) {
+		return b.List(ctx, params, opts...)
+	}
+
+	return fetch.Paginate(ctx, params, nextPage, list)
+}
+
+// ListAll percorre todas as páginas de /v1/billing/list e retorna as
+// cobranças em uma única resposta.
+func (b *Billing) ListAll(ctx context.Context, opts ...fetch.RequestOptions) (*ListBillingResponse, error) {
+	all := &ListBillingResponse{}
+
+	for item, err := range b.All(ctx, nil, opts...) {
+		if err != nil {
+			return nil, err
+		}
+
+		all.Data = append(all.Data, item)
+	}
+
+	return all, nil
+}
+
+// nextPage calcula os parâmetros da página seguinte a partir dos parâmetros
+// da requisição atual. Retorna false quando a API repete o cursor enviado ou
+// responde com um número de página diferente do pedido, evitando buscar a
+// mesma página indefinidamente.
+func nextPage(params *ListBillingParams, pagination *Pagination) (*ListBillingParams, bool) {
+	next := *params
+	if pagination.NextCursor != "" {
+		if pagination.NextCursor == params.Cursor {
+			return nil, false
+		}
+
+		next.Cursor = pagination.NextCursor
+		next.Page = 0
+
+		return &next, true
+	}
+
+	current := params.Page
+	if current == 0 {
+		current = 1
+	}
+
+	if params.Cursor != "" && pagination.Page > 0 {
+		current = pagination.Page
+	} else if pagination.Page > 0 && pagination.Page != current {
+		return nil, false
+	}
+
+	next.Cursor = ""
+	next.Page = current + 1
+
+	return &next, true
+}
@@ -3,14 +3,17 @@ package billing_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
 	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
 )
 
@@ -54,6 +57,9 @@ func TestCreate(t *testing.T) {
 					Price:       100,
 				},
 			},
+			Customer: &billing.BillingCustomer{
+				Email: "test@example.com",
+			},
 		}
 
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -138,3 +144,382 @@ func TestListAll(t *testing.T) {
 		assert.NotNil(t, response.Data)
 	})
 }
+
+func TestList(t *testing.T) {
+	t.Run("Should send filters and pagination as query params", func(t *testing.T) {
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+
+			assert.Equal(t, "/v1/billing/list", r.URL.Path)
+			assert.Equal(t, "PAID", query.Get("status"))
+			assert.Equal(t, "cust_1234", query.Get("customerId"))
+			assert.Equal(t, "2024-01-01T00:00:00Z", query.Get("createdFrom"))
+			assert.Equal(t, "2024-01-31T00:00:00Z", query.Get("createdTo"))
+			assert.Equal(t, "10", query.Get("limit"))
+			assert.Equal(t, "cursor_1234", query.Get("cursor"))
+			assert.Empty(t, query.Get("page"))
+
+			resp := billing.ListBillingResponse{
+				Data:       []billing.BillingListItem{{ID: "bill_1234"}},
+				Pagination: &billing.Pagination{Limit: 10, NextCursor: "cursor_5678"},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := billing.New(client).List(context.Background(), &billing.ListBillingParams{
+			Status:      billing.Paid,
+			CustomerId:  "cust_1234",
+			CreatedFrom: from,
+			CreatedTo:   to,
+			Limit:       10,
+			Cursor:      "cursor_1234",
+			Page:        3,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, "cursor_5678", response.Pagination.NextCursor)
+	})
+}
+
+func TestListAllPages(t *testing.T) {
+	t.Run("Should walk all pages", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			query := r.URL.Query()
+
+			var resp billing.ListBillingResponse
+			switch calls {
+			case 1:
+				assert.Empty(t, query.Get("page"))
+				resp.Data = []billing.BillingListItem{{ID: "bill_1"}}
+				resp.Pagination = &billing.Pagination{Page: 1, TotalPages: 2}
+			case 2:
+				assert.Equal(t, "2", query.Get("page"))
+				resp.Data = []billing.BillingListItem{{ID: "bill_2"}}
+				resp.Pagination = &billing.Pagination{Page: 2, TotalPages: 3, NextCursor: "cursor_3"}
+			case 3:
+				assert.Equal(t, "cursor_3", query.Get("cursor"))
+				resp.Data = []billing.BillingListItem{{ID: "bill_3"}}
+				resp.Pagination = &billing.Pagination{Page: 3, TotalPages: 3}
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := billing.New(client).ListAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		assert.Len(t, response.Data, 3)
+		assert.Equal(t, "bill_3", response.Data[2].ID)
+	})
+
+	t.Run("Should walk pages that only report hasMore", func(t *testing.T) {
+		var queries []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+
+			resp := billing.ListBillingResponse{
+				Data:       []billing.BillingItem{{ID: fmt.Sprintf("bill_%d", len(queries))}},
+				Pagination: &billing.Pagination{HasMore: len(queries) < 3},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := billing.New(client).ListAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"", "page=2", "page=3"}, queries)
+		assert.Len(t, response.Data, 3)
+		assert.Equal(t, "bill_3", response.Data[2].ID)
+	})
+
+	t.Run("Should stop when the API repeats the page or cursor", func(t *testing.T) {
+		for name, pagination := range map[string]*billing.Pagination{
+			"page":   {Page: 1, HasMore: true},
+			"cursor": {NextCursor: "cursor_1", HasMore: true},
+		} {
+			t.Run(name, func(t *testing.T) {
+				calls := 0
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					calls++
+
+					resp := billing.ListBillingResponse{
+						Data:       []billing.BillingItem{{ID: "bill_1"}},
+						Pagination: pagination,
+					}
+
+					json.NewEncoder(w).Encode(resp)
+				}))
+				defer server.Close()
+
+				client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+				assert.NoError(t, err)
+
+				response, err := billing.New(client).ListAll(context.Background())
+
+				assert.NoError(t, err)
+				assert.Equal(t, 2, calls)
+				assert.Len(t, response.Data, 2)
+			})
+		}
+	})
+
+	t.Run("Should not truncate responses without pagination", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			total := 150
+			if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit < total {
+				total = limit
+			}
+
+			var resp billing.ListBillingResponse
+			for i := 0; i < total; i++ {
+				resp.Data = append(resp.Data, billing.BillingItem{ID: fmt.Sprintf("bill_%d", i)})
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		b := billing.New(client)
+
+		page, err := b.List(context.Background(), &billing.ListBillingParams{Limit: 100})
+		assert.NoError(t, err)
+		assert.Len(t, page.Data, 100)
+		assert.Nil(t, page.Pagination)
+
+		response, err := b.ListAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 150)
+	})
+}
+
+func TestAll(t *testing.T) {
+	newServer := func(calls *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls++
+			if *calls == 3 {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"data": null, "error": "Invalid API key"}`))
+				return
+			}
+
+			resp := billing.ListBillingResponse{
+				Data: []billing.BillingListItem{
+					{ID: fmt.Sprintf("bill_%d_a", *calls)},
+					{ID: fmt.Sprintf("bill_%d_b", *calls)},
+				},
+				Pagination: &billing.Pagination{Page: *calls, TotalPages: 5},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+	}
+
+	t.Run("Should fetch pages lazily and stop early", func(t *testing.T) {
+		calls := 0
+		server := newServer(&calls)
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		var ids []string
+		for item, err := range billing.New(client).All(context.Background(), nil) {
+			assert.NoError(t, err)
+
+			ids = append(ids, item.ID)
+			if len(ids) == 3 {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"bill_1_a", "bill_1_b", "bill_2_a"}, ids)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Should yield the API error as the last element", func(t *testing.T) {
+		calls := 0
+		server := newServer(&calls)
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		var items int
+		var lastErr error
+		for _, err := range billing.New(client).All(context.Background(), &billing.ListBillingParams{Limit: 2}) {
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			items++
+		}
+
+		var apiErr *fetch.APIError
+		assert.ErrorAs(t, lastErr, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+		assert.Equal(t, 4, items)
+	})
+
+	t.Run("Should respect context cancellation", func(t *testing.T) {
+		calls := 0
+		server := newServer(&calls)
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var lastErr error
+		for item, err := range billing.New(client).All(ctx, nil) {
+			if err != nil {
+				lastErr = err
+				break
+			}
+
+			if item.ID == "bill_1_b" {
+				cancel()
+			}
+		}
+
+		assert.ErrorIs(t, lastErr, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRequestOptions(t *testing.T) {
+	t.Run("Should forward request options", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "test-value", r.Header.Get("X-Custom-Header"))
+
+			json.NewEncoder(w).Encode(billing.ListBillingResponse{})
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		b := billing.New(client)
+
+		_, err = b.ListAll(context.Background(), fetch.RequestOptions{
+			Timeout: 30 * time.Second,
+			Headers: map[string]string{"X-Custom-Header": "test-value"},
+		})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	newBody := func() *billing.CreateBillingBody {
+		return &billing.CreateBillingBody{
+			Frequency:     billing.MultiplePayments,
+			Methods:       []billing.Method{billing.PIX, billing.CARD},
+			CompletionUrl: "https://example.com/completion",
+			ReturnUrl:     "https://example.com/return",
+			CustomerId:    "cust_123",
+			Products: []*billing.BillingProduct{
+				{ExternalId: "pix-1234", Name: "PIX", Quantity: 1, Price: 100},
+			},
+		}
+	}
+
+	t.Run("Should accept known frequencies and methods", func(t *testing.T) {
+		assert.NoError(t, newBody().Validate())
+	})
+
+	t.Run("Should reject unknown frequency", func(t *testing.T) {
+		body := newBody()
+		body.Frequency = "WEEKLY"
+
+		assert.Error(t, body.Validate())
+	})
+
+	t.Run("Should validate customer tax ID", func(t *testing.T) {
+		body := newBody()
+		body.Customer = &billing.BillingCustomer{Email: "test@example.com", TaxID: "11.222.333/0001-82"}
+
+		assert.Error(t, body.Validate())
+
+		body.Customer.TaxID = "11.222.333/0001-81"
+
+		assert.NoError(t, body.Validate())
+	})
+
+	t.Run("Should validate customer cellphone", func(t *testing.T) {
+		body := newBody()
+		body.Customer = &billing.BillingCustomer{Email: "test@example.com", Cellphone: "(11) 4002-8922"}
+
+		assert.Error(t, body.Validate())
+
+		body.Customer.Cellphone = "(11) 9 8765-4321"
+
+		assert.NoError(t, body.Validate())
+	})
+
+	t.Run("Should reject unknown method", func(t *testing.T) {
+		body := newBody()
+		body.Methods = []billing.Method{billing.PIX, "BOLETO"}
+
+		assert.Error(t, body.Validate())
+	})
+
+	t.Run("Should report field paths with JSON names", func(t *testing.T) {
+		body := newBody()
+		body.Products[0].Price = 50
+
+		var validationErr *validation.ValidationError
+		assert.ErrorAs(t, body.Validate(), &validationErr)
+		assert.Len(t, validationErr.Fields, 1)
+		assert.Equal(t, "products[0].price", validationErr.Fields[0].Field)
+		assert.Equal(t, "gte", validationErr.Fields[0].Rule)
+		assert.Equal(t, "products[0].price deve ser maior ou igual a 100", validationErr.Fields[0].Message(validation.PtBR))
+	})
+
+	t.Run("Should require customerId or customer email", func(t *testing.T) {
+		body := newBody()
+		body.CustomerId = ""
+
+		var validationErr *validation.ValidationError
+		assert.ErrorAs(t, body.Validate(), &validationErr)
+		assert.Equal(t, "customer.email", validationErr.Fields[0].Field)
+		assert.Equal(t, "required_without", validationErr.Fields[0].Rule)
+	})
+}
+
+func TestBillingCustomerNormalize(t *testing.T) {
+	t.Run("Should normalize cellphone to E.164 without changing the original", func(t *testing.T) {
+		customer := &billing.BillingCustomer{Email: "test@example.com", Cellphone: "(11) 9 8765-4321"}
+
+		normalized := customer.Normalize()
+
+		assert.Equal(t, "+5511987654321", normalized.Cellphone)
+		assert.Equal(t, "(11) 9 8765-4321", customer.Cellphone)
+	})
+}
@@ -1,36 +1,58 @@
 package billing
 
 import (
+	"encoding/json"
+	"net/url"
+	"strconv"
 	"time"
 
-	"github.com/go-playground/validator/v10"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+	"github.com/AbacatePay/abacatepay-go-sdk/phone"
 )
 
-var validate *validator.Validate
+var validate *validation.Validator
 
 type CreateBillingBody struct {
-	Frequency     Frequency         `json:"frequency"     validate:"required"`
-	Methods       []Method          `json:"methods"       validate:"required,dive"`
+	Frequency     Frequency         `json:"frequency"     validate:"required,enum"`
+	Methods       []Method          `json:"methods"       validate:"required,dive,enum"`
 	ReturnUrl     string            `json:"returnUrl"     validate:"required,url"`
 	CompletionUrl string            `json:"completionUrl" validate:"required,url"`
 	Products      []*BillingProduct `json:"products"      validate:"required,dive"`
 	CustomerId    string            `json:"customerId"`
 	Customer      *BillingCustomer  `json:"customer"`
+	AllowCoupons  bool              `json:"allowCoupons,omitempty"`
+	Coupons       []string          `json:"coupons,omitempty"       validate:"omitempty,max=50"`
 }
 
 type BillingCustomer struct {
 	Name      string `json:"name"`
-	Cellphone string `json:"cellphone"`
-	Email     string `json:"email" validate:"required"`
-	TaxID     string `json:"taxId"`
+	Cellphone string `json:"cellphone" validate:"omitempty,cellphone"`
+	Email     string `json:"email"     validate:"required"`
+	TaxID     string `json:"taxId"     validate:"omitempty,taxid"`
+}
+
+// Normalize retorna uma cópia do cliente com o celular no formato E.164.
+func (c *BillingCustomer) Normalize() *BillingCustomer {
+	if c == nil {
+		return nil
+	}
+
+	customer := *c
+	if cellphone, err := phone.Normalize(c.Cellphone); err == nil {
+		customer.Cellphone = cellphone
+	}
+
+	return &customer
 }
 
 type BillingProduct struct {
-	ExternalId  string `json:"externalId"  validate:"required"`
-	Name        string `json:"name"        validate:"required"`
-	Description string `json:"description"`
-	Quantity    int    `json:"quantity"    validate:"required,gte=1"`
-	Price       int    `json:"price"       validate:"required,gte=100"`
+	ExternalId  string      `json:"externalId"  validate:"required"`
+	Name        string      `json:"name"        validate:"required"`
+	Description string      `json:"description"`
+	Quantity    int         `json:"quantity"    validate:"required,gte=1"`
+	Price       money.Money `json:"price"       validate:"required,gte=100"`
 }
 
 type ProductItem struct {
@@ -39,36 +61,10 @@ type ProductItem struct {
 	Quantity   int    `json:"quantity"`
 }
 
-type CreateBillingResponseItem struct {
-	PublicID  string        `json:"publicId"`
-	Products  []ProductItem `json:"products"`
-	Amount    int64         `json:"amount"`
-	Status    string        `json:"status"`
-	DevMode   bool          `json:"devMode"`
-	Methods   []string      `json:"methods"`
-	Frequency string        `json:"frequency"`
-	Metadata  struct {
-		Fee           int64  `json:"fee"`
-		ReturnURL     string `json:"returnUrl"`
-		CompletionURL string `json:"completionUrl"`
-	} `json:"metadata"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updatedAt"`
-	ID        string `json:"_id"`
-	Version   int    `json:"__v"`
-	URL       string `json:"url"`
-	BillingID string `json:"id"`
-}
-
-type CreateBillingResponse struct {
-	Data  CreateBillingResponseItem `json:"data"`
-	Error string                    `json:"error"`
-}
-
 type Metadata struct {
-	Fee           int    `json:"fee"`
-	ReturnURL     string `json:"returnUrl"`
-	CompletionURL string `json:"completionUrl"`
+	Fee           money.Money `json:"fee"`
+	ReturnURL     string      `json:"returnUrl"`
+	CompletionURL string      `json:"completionUrl"`
 }
 
 type CustomerMetadata struct {
@@ -81,56 +77,156 @@ type CustomerMetadata struct {
 // Customer representa o cliente associado a uma cobrança.
 type Customer struct {
 	Metadata  CustomerMetadata `json:"metadata"`
-	ID        string           `json:"_id"`
-	PublicID  string           `json:"publicId"`
-	AccountID string           `json:"accountId"`
-	StoreID   string           `json:"storeId"`
+	ID        string           `json:"id"`
+	PublicID  string           `json:"publicId,omitempty"`
+	AccountID string           `json:"accountId,omitempty"`
+	StoreID   string           `json:"storeId,omitempty"`
 	DevMode   bool             `json:"devMode"`
 	CreatedAt time.Time        `json:"createdAt"`
 	UpdatedAt time.Time        `json:"updatedAt"`
 	Version   int              `json:"__v"`
 }
 
-type BillingListItem struct {
-	ID       string   `json:"id"`
-	Metadata Metadata `json:"metadata"`
-	Customer struct {
-		ID       string           `json:"id"`
-		Metadata CustomerMetadata `json:"metadata"`
-	} `json:"customer"`
-	CustomerId struct {
-		Metadata  CustomerMetadata `json:"metadata"`
-		ID        string           `json:"id"`
-		PublicID  string           `json:"publicId"`
-		AccountID string           `json:"accountId"`
-		StoreID   string           `json:"storeId"`
-		DevMode   bool             `json:"devMode"`
-		CreatedAt time.Time        `json:"createdAt"`
-		UpdatedAt time.Time        `json:"updatedAt"`
-		Version   int              `json:"__v"`
-	} `json:"customerId"`
-	PublicID  string        `json:"publicId"`
-	Amount    int64         `json:"amount"`
-	Status    string        `json:"status"`
+// UnmarshalJSON aceita também o identificador legado _id.
+func (c *Customer) UnmarshalJSON(data []byte) error {
+	type customer Customer
+
+	var raw struct {
+		customer
+		LegacyID string `json:"_id"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*c = Customer(raw.customer)
+	if c.ID == "" {
+		c.ID = raw.LegacyID
+	}
+
+	return nil
+}
+
+// BillingItem é a cobrança retornada tanto na criação quanto na listagem.
+type BillingItem struct {
+	ID        string        `json:"id"`
+	PublicID  string        `json:"publicId,omitempty"`
+	URL       string        `json:"url"`
+	Amount    money.Money   `json:"amount"`
+	Status    Status        `json:"status"`
 	DevMode   bool          `json:"devMode"`
 	Methods   []Method      `json:"methods"`
 	Frequency Frequency     `json:"frequency"`
+	Products  []ProductItem `json:"products"`
+	Metadata  Metadata      `json:"metadata"`
+	Customer  *Customer     `json:"customer,omitempty"`
 	CreatedAt time.Time     `json:"createdAt"`
 	UpdatedAt time.Time     `json:"updatedAt"`
 	Version   int           `json:"__v"`
-	URL       string        `json:"url"`
-	Products  []ProductItem `json:"products"`
 }
 
-type ListBillingResponse struct {
-	Data  []BillingListItem `json:"data"`
-	Error string            `json:"error"`
+// UnmarshalJSON aceita o identificador legado _id e o cliente enviado em
+// customerId, seja como objeto ou apenas como ID.
+func (b *BillingItem) UnmarshalJSON(data []byte) error {
+	type billingItem BillingItem
+
+	var raw struct {
+		billingItem
+		LegacyID   string          `json:"_id"`
+		CustomerId json.RawMessage `json:"customerId"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*b = BillingItem(raw.billingItem)
+	if b.ID == "" {
+		b.ID = raw.LegacyID
+	}
+
+	if b.Customer == nil && len(raw.CustomerId) > 0 && string(raw.CustomerId) != "null" {
+		var customer Customer
+		if err := json.Unmarshal(raw.CustomerId, &customer); err != nil {
+			var id string
+			if json.Unmarshal(raw.CustomerId, &id) != nil {
+				return err
+			}
+			customer.ID = id
+		}
+		b.Customer = &customer
+	}
+
+	return nil
+}
+
+// Deprecated: use BillingItem.
+type CreateBillingResponseItem = BillingItem
+
+// Deprecated: use BillingItem.
+type BillingListItem = BillingItem
+
+type CreateBillingResponse = fetch.Response[BillingItem]
+
+type ListBillingResponse = fetch.Response[[]BillingItem]
+
+type Pagination = fetch.Pagination
+
+// ListBillingParams filtra e pagina o resultado de Billing.List. Campos
+// vazios são ignorados. Quando Cursor é informado, Page é ignorado.
+type ListBillingParams struct {
+	Status      Status
+	CustomerId  string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Limit       int
+	Cursor      string
+	Page        int
+}
+
+func (p *ListBillingParams) query() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+
+	if p.Status != "" {
+		query.Set("status", string(p.Status))
+	}
+	if p.CustomerId != "" {
+		query.Set("customerId", p.CustomerId)
+	}
+	if !p.CreatedFrom.IsZero() {
+		query.Set("createdFrom", p.CreatedFrom.UTC().Format(time.RFC3339))
+	}
+	if !p.CreatedTo.IsZero() {
+		query.Set("createdTo", p.CreatedTo.UTC().Format(time.RFC3339))
+	}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Cursor != "" {
+		query.Set("cursor", p.Cursor)
+	} else if p.Page > 0 {
+		query.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return query
 }
 
 func init() {
-	validate = validator.New()
+	validate = validation.New()
 }
 
 func (p *CreateBillingBody) Validate() error {
-	return validate.Struct(p)
+	if err := validate.Struct(p); err != nil {
+		return err
+	}
+
+	if p.CustomerId == "" && (p.Customer == nil || p.Customer.Email == "") {
+		return validation.NewError("customer.email", "required_without", "customerId")
+	}
+
+	return nil
 }
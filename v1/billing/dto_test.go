@@ -0,0 +1,64 @@
+package billing_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+)
+
+func TestBillingItem(t *testing.T) {
+	t.Run("Should decode create and list payloads into the same shape", func(t *testing.T) {
+		var created billing.CreateBillingResponse
+		var listed billing.ListBillingResponse
+
+		item := `{
+			"id": "bill_1234",
+			"url": "https://abacatepay.com/pay/bill_1234",
+			"amount": 1000,
+			"status": "PENDING",
+			"methods": ["PIX"],
+			"frequency": "ONE_TIME",
+			"metadata": {"fee": 80},
+			"customer": {"id": "cust_1234", "metadata": {"email": "test@example.com"}},
+			"createdAt": "2024-11-04T18:38:28.573Z",
+			"updatedAt": "2024-11-04T18:38:28.573Z"
+		}`
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"data": `+item+`}`), &created))
+		assert.NoError(t, json.Unmarshal([]byte(`{"data": [`+item+`]}`), &listed))
+
+		assert.Equal(t, created.Data, listed.Data[0])
+		assert.Equal(t, "bill_1234", created.Data.ID)
+		assert.Equal(t, money.Money(80), created.Data.Metadata.Fee)
+		assert.Equal(t, "cust_1234", created.Data.Customer.ID)
+		assert.Equal(t, time.Date(2024, 11, 4, 18, 38, 28, 573000000, time.UTC), created.Data.CreatedAt)
+	})
+
+	t.Run("Should accept legacy identifiers and customerId objects", func(t *testing.T) {
+		var item billing.BillingItem
+
+		err := json.Unmarshal([]byte(`{
+			"_id": "bill_1234",
+			"customerId": {"_id": "cust_1234", "metadata": {"name": "Test"}}
+		}`), &item)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "bill_1234", item.ID)
+		assert.Equal(t, "cust_1234", item.Customer.ID)
+		assert.Equal(t, "Test", item.Customer.Metadata.Name)
+	})
+
+	t.Run("Should accept customerId as a plain ID", func(t *testing.T) {
+		var item billing.BillingItem
+
+		err := json.Unmarshal([]byte(`{"id": "bill_1234", "customerId": "cust_1234"}`), &item)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cust_1234", item.Customer.ID)
+	})
+}
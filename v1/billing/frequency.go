@@ -3,5 +3,15 @@ package billing
 type Frequency string
 
 const (
-	OneTime Frequency = "ONE_TIME"
+	OneTime          Frequency = "ONE_TIME"
+	MultiplePayments Frequency = "MULTIPLE_PAYMENTS"
 )
+
+func (f Frequency) IsValid() bool {
+	switch f {
+	case OneTime, MultiplePayments:
+		return true
+	}
+
+	return false
+}
@@ -3,5 +3,15 @@ package billing
 type Method string
 
 const (
-	PIX Method = "PIX"
+	PIX  Method = "PIX"
+	CARD Method = "CARD"
 )
+
+func (m Method) IsValid() bool {
+	switch m {
+	case PIX, CARD:
+		return true
+	}
+
+	return false
+}
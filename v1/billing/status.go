@@ -0,0 +1,56 @@
+package billing
+
+import "encoding/json"
+
+type Status string
+
+const (
+	Pending   Status = "PENDING"
+	Expired   Status = "EXPIRED"
+	Cancelled Status = "CANCELLED"
+	Paid      Status = "PAID"
+	Refunded  Status = "REFUNDED"
+)
+
+// IsKnown indica se o status é um dos valores definidos pelo SDK.
+func (s Status) IsKnown() bool {
+	switch s {
+	case Pending, Expired, Cancelled, Paid, Refunded:
+		return true
+	}
+
+	return false
+}
+
+// IsFinal indica se a cobrança não aguarda mais pagamento. Um status PAID
+// ainda pode passar a REFUNDED.
+func (s Status) IsFinal() bool {
+	switch s {
+	case Expired, Cancelled, Paid, Refunded:
+		return true
+	}
+
+	return false
+}
+
+func (s Status) IsPaid() bool {
+	return s == Paid
+}
+
+// UnmarshalJSON aceita qualquer string, preservando status ainda não
+// conhecidos pelo SDK, e trata null como status vazio.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var value *string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	if value == nil {
+		*s = ""
+		return nil
+	}
+
+	*s = Status(*value)
+
+	return nil
+}
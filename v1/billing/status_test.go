@@ -0,0 +1,51 @@
+package billing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+)
+
+func TestStatus(t *testing.T) {
+	t.Run("Should report final and paid states", func(t *testing.T) {
+		assert.False(t, billing.Pending.IsFinal())
+		assert.True(t, billing.Paid.IsFinal())
+		assert.True(t, billing.Expired.IsFinal())
+		assert.True(t, billing.Cancelled.IsFinal())
+		assert.True(t, billing.Refunded.IsFinal())
+
+		assert.True(t, billing.Paid.IsPaid())
+		assert.False(t, billing.Refunded.IsPaid())
+	})
+
+	t.Run("Should preserve unknown values when unmarshalling", func(t *testing.T) {
+		var item billing.BillingListItem
+
+		err := json.Unmarshal([]byte(`{"status": "UNDER_REVIEW"}`), &item)
+
+		assert.NoError(t, err)
+		assert.Equal(t, billing.Status("UNDER_REVIEW"), item.Status)
+		assert.False(t, item.Status.IsKnown())
+		assert.False(t, item.Status.IsFinal())
+	})
+
+	t.Run("Should unmarshal known values and null", func(t *testing.T) {
+		var item billing.CreateBillingResponseItem
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"status": "PAID"}`), &item))
+		assert.Equal(t, billing.Paid, item.Status)
+		assert.True(t, item.Status.IsKnown())
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"status": null}`), &item))
+		assert.Equal(t, billing.Status(""), item.Status)
+	})
+
+	t.Run("Should reject non string values", func(t *testing.T) {
+		var status billing.Status
+
+		assert.Error(t, json.Unmarshal([]byte(`1`), &status))
+	})
+}
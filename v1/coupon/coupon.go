@@ -0,0 +1,57 @@
+package coupon
+
+import (
+	"context"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+)
+
+type Coupon struct {
+	HttpClient *fetch.Fetch
+}
+
+func New(httpClient *fetch.Fetch) *Coupon {
+	return &Coupon{
+		HttpClient: httpClient,
+	}
+}
+
+func (c *Coupon) Create(
+	ctx context.Context,
+	body *CreateCouponBody,
+	opts ...fetch.RequestOptions,
+) (*CreateCouponResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	var response CreateCouponResponse
+
+	resp, err := c.HttpClient.Post(ctx, "/v1/coupon/create", body, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Coupon) ListAll(ctx context.Context, opts ...fetch.RequestOptions) (*ListCouponResponse, error) {
+	var response ListCouponResponse
+
+	resp, err := c.HttpClient.Get(ctx, "/v1/coupon/list", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
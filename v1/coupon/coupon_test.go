@@ -0,0 +1,190 @@
+package coupon_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/coupon"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Create new client with valid params", func(t *testing.T) {
+		client := coupon.New(nil)
+		assert.NotNil(t, client)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should validate body", func(t *testing.T) {
+		client := coupon.New(nil)
+
+		body := &coupon.CreateCouponBody{
+			Code:         "PROMO10",
+			DiscountKind: "UNKNOWN",
+			Percentage:   10,
+			MaxRedeems:   -1,
+		}
+
+		response, err := client.Create(context.Background(), body)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should reject percentage above 100", func(t *testing.T) {
+		client := coupon.New(nil)
+
+		body := &coupon.CreateCouponBody{
+			Code:         "PROMO150",
+			DiscountKind: coupon.Percentage,
+			Percentage:   150,
+			MaxRedeems:   -1,
+		}
+
+		response, err := client.Create(context.Background(), body)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should create new coupon", func(t *testing.T) {
+		body := &coupon.CreateCouponBody{
+			Code:         "PROMO10",
+			DiscountKind: coupon.Percentage,
+			Percentage:   10,
+			MaxRedeems:   -1,
+			Notes:        "Black friday",
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var bodyRef coupon.CreateCouponBody
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+			assert.Equal(t, "/v1/coupon/create", r.URL.Path)
+
+			defer r.Body.Close()
+
+			json.NewDecoder(r.Body).Decode(&bodyRef)
+
+			assert.Equal(t, *body, bodyRef)
+
+			resp := coupon.CreateCouponResponse{
+				Data: coupon.CouponItem{
+					ID:           "PROMO10",
+					DiscountKind: coupon.Percentage,
+					Percentage:   10,
+					Status:       "ACTIVE",
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := coupon.New(client).Create(context.Background(), body)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "PROMO10", response.Data.ID)
+	})
+}
+
+func TestDiscount(t *testing.T) {
+	t.Run("Should send fixed discounts in centavos", func(t *testing.T) {
+		body := &coupon.CreateCouponBody{
+			Code:         "MINUS5",
+			DiscountKind: coupon.Fixed,
+			Amount:       money.FromCents(500),
+		}
+
+		data, err := json.Marshal(body)
+		assert.NoError(t, err)
+
+		var raw map[string]interface{}
+		assert.NoError(t, json.Unmarshal(data, &raw))
+		assert.Equal(t, float64(500), raw["discount"])
+		assert.NotContains(t, raw, "Amount")
+	})
+
+	t.Run("Should require the field matching the discount kind", func(t *testing.T) {
+		body := &coupon.CreateCouponBody{
+			Code:         "MINUS5",
+			DiscountKind: coupon.Fixed,
+			Percentage:   5,
+			MaxRedeems:   -1,
+		}
+
+		assert.Error(t, body.Validate())
+
+		body.Amount = 500
+
+		assert.NoError(t, body.Validate())
+	})
+
+	t.Run("Should require MaxRedeems to be -1 or positive", func(t *testing.T) {
+		body := &coupon.CreateCouponBody{
+			Code:         "PROMO10",
+			DiscountKind: coupon.Percentage,
+			Percentage:   10,
+		}
+
+		assert.Error(t, body.Validate())
+
+		body.MaxRedeems = -2
+		assert.Error(t, body.Validate())
+
+		body.MaxRedeems = -1
+		assert.NoError(t, body.Validate())
+
+		body.MaxRedeems = 50
+		assert.NoError(t, body.Validate())
+	})
+
+	t.Run("Should decode the discount by kind", func(t *testing.T) {
+		var item coupon.CouponItem
+		err := json.Unmarshal([]byte(`{"id": "PROMO10", "discountKind": "PERCENTAGE", "discount": 10}`), &item)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 10, item.Percentage)
+		assert.Zero(t, item.Amount)
+	})
+}
+
+func TestListAll(t *testing.T) {
+	t.Run("Should list all coupons", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/coupon/list", r.URL.Path)
+
+			resp := coupon.ListCouponResponse{
+				Data: []coupon.CouponItem{
+					{ID: "PROMO10", DiscountKind: coupon.Fixed, Amount: 500},
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := coupon.New(client).ListAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, coupon.Fixed, response.Data[0].DiscountKind)
+		assert.Equal(t, money.Money(500), response.Data[0].Amount)
+	})
+}
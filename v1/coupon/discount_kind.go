@@ -0,0 +1,8 @@
+package coupon
+
+type DiscountKind string
+
+const (
+	Percentage DiscountKind = "PERCENTAGE"
+	Fixed      DiscountKind = "FIXED"
+)
@@ -0,0 +1,144 @@
+package coupon
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+)
+
+var validate *validation.Validator
+
+// CreateCouponBody descreve um cupom. A API recebe o desconto em um único
+// campo discount; informe Percentage para cupons PERCENTAGE ou Amount para
+// cupons FIXED.
+type CreateCouponBody struct {
+	Code         string       `json:"code"               validate:"required"`
+	DiscountKind DiscountKind `json:"discountKind"       validate:"required,oneof=PERCENTAGE FIXED"`
+	// Percentage é o desconto, de 1 a 100, dos cupons PERCENTAGE.
+	Percentage int `json:"-"`
+	// Amount é o desconto em centavos dos cupons FIXED.
+	Amount money.Money `json:"-"`
+	// MaxRedeems é o limite de usos do cupom. Use -1 para usos ilimitados.
+	MaxRedeems int                    `json:"maxRedeems"         validate:"required,gte=-1"`
+	Notes      string                 `json:"notes,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type CouponItem struct {
+	ID           string       `json:"id"`
+	DiscountKind DiscountKind `json:"discountKind"`
+	// Percentage é preenchido nos cupons PERCENTAGE e Amount nos cupons FIXED.
+	Percentage   int                    `json:"-"`
+	Amount       money.Money            `json:"-"`
+	Status       string                 `json:"status"`
+	Notes        string                 `json:"notes"`
+	MaxRedeems   int                    `json:"maxRedeems"`
+	RedeemsCount int                    `json:"redeemsCount"`
+	DevMode      bool                   `json:"devMode"`
+	Metadata     map[string]interface{} `json:"metadata"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+func (p CreateCouponBody) MarshalJSON() ([]byte, error) {
+	type createCouponBody CreateCouponBody
+
+	return json.Marshal(struct {
+		createCouponBody
+		Discount int64 `json:"discount"`
+	}{createCouponBody(p), discountValue(p.DiscountKind, p.Percentage, p.Amount)})
+}
+
+func (p *CreateCouponBody) UnmarshalJSON(data []byte) error {
+	type createCouponBody CreateCouponBody
+
+	var raw struct {
+		createCouponBody
+		Discount int64 `json:"discount"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*p = CreateCouponBody(raw.createCouponBody)
+	p.Percentage, p.Amount = splitDiscount(p.DiscountKind, raw.Discount)
+
+	return nil
+}
+
+func (c CouponItem) MarshalJSON() ([]byte, error) {
+	type couponItem CouponItem
+
+	return json.Marshal(struct {
+		couponItem
+		Discount int64 `json:"discount"`
+	}{couponItem(c), discountValue(c.DiscountKind, c.Percentage, c.Amount)})
+}
+
+func (c *CouponItem) UnmarshalJSON(data []byte) error {
+	type couponItem CouponItem
+
+	var raw struct {
+		couponItem
+		Discount int64 `json:"discount"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*c = CouponItem(raw.couponItem)
+	c.Percentage, c.Amount = splitDiscount(c.DiscountKind, raw.Discount)
+
+	return nil
+}
+
+func discountValue(kind DiscountKind, percentage int, amount money.Money) int64 {
+	if kind == Fixed {
+		return amount.Cents()
+	}
+
+	return int64(percentage)
+}
+
+func splitDiscount(kind DiscountKind, discount int64) (int, money.Money) {
+	if kind == Fixed {
+		return 0, money.FromCents(discount)
+	}
+
+	return int(discount), 0
+}
+
+type CreateCouponResponse = fetch.Response[CouponItem]
+
+type ListCouponResponse = fetch.Response[[]CouponItem]
+
+func init() {
+	validate = validation.New()
+}
+
+func (p *CreateCouponBody) Validate() error {
+	if err := validate.Struct(p); err != nil {
+		return err
+	}
+
+	switch p.DiscountKind {
+	case Percentage:
+		if p.Percentage <= 0 {
+			return validation.NewError("discount", "gt", "0")
+		}
+		if p.Percentage > 100 {
+			return validation.NewError("discount", "lte", "100")
+		}
+	case Fixed:
+		if p.Amount <= 0 {
+			return validation.NewError("discount", "gt", "0")
+		}
+	}
+
+	return nil
+}
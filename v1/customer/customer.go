@@ -0,0 +1,57 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+)
+
+type Customer struct {
+	HttpClient *fetch.Fetch
+}
+
+func New(httpClient *fetch.Fetch) *Customer {
+	return &Customer{
+		HttpClient: httpClient,
+	}
+}
+
+func (c *Customer) Create(
+	ctx context.Context,
+	body *CreateCustomerBody,
+	opts ...fetch.RequestOptions,
+) (*CreateCustomerResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	var response CreateCustomerResponse
+
+	resp, err := c.HttpClient.Post(ctx, "/v1/customer/create", body.normalized(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Customer) ListAll(ctx context.Context, opts ...fetch.RequestOptions) (*ListCustomerResponse, error) {
+	var response ListCustomerResponse
+
+	resp, err := c.HttpClient.Get(ctx, "/v1/customer/list", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
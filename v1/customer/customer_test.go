@@ -0,0 +1,155 @@
+package customer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/customer"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Create new client with valid params", func(t *testing.T) {
+		client := customer.New(nil)
+		assert.NotNil(t, client)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should validate body", func(t *testing.T) {
+		client := customer.New(nil)
+
+		body := &customer.CreateCustomerBody{
+			Name:  "Test",
+			Email: "not-an-email",
+		}
+
+		ctx := context.Background()
+
+		response, err := client.Create(ctx, body)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should reject invalid tax ID", func(t *testing.T) {
+		client := customer.New(nil)
+
+		body := &customer.CreateCustomerBody{
+			Name:      "Test",
+			Cellphone: "(11) 9 8765-4321",
+			Email:     "test@example.com",
+			TaxID:     "123.456.789-01",
+		}
+
+		response, err := client.Create(context.Background(), body)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should reject invalid cellphone", func(t *testing.T) {
+		client := customer.New(nil)
+
+		body := &customer.CreateCustomerBody{
+			Name:      "Test",
+			Cellphone: "(10) 9 8765-4321",
+			Email:     "test@example.com",
+			TaxID:     "529.982.247-25",
+		}
+
+		response, err := client.Create(context.Background(), body)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should create new customer", func(t *testing.T) {
+		body := &customer.CreateCustomerBody{
+			Name:      "Test",
+			Cellphone: "(11) 9 8765-4321",
+			Email:     "test@example.com",
+			TaxID:     "529.982.247-25",
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var bodyRef customer.CreateCustomerBody
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "/v1/customer/create", r.URL.Path)
+
+			defer r.Body.Close()
+
+			json.NewDecoder(r.Body).Decode(&bodyRef)
+
+			expected := *body
+			expected.Cellphone = "+5511987654321"
+			assert.Equal(t, expected, bodyRef)
+
+			resp := customer.CreateCustomerResponse{
+				Data: billing.Customer{
+					ID: "cust_1234",
+					Metadata: billing.CustomerMetadata{
+						Name:  body.Name,
+						Email: body.Email,
+					},
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		c := customer.New(client)
+
+		ctx := context.Background()
+
+		response, err := c.Create(ctx, body)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cust_1234", response.Data.ID)
+	})
+}
+
+func TestListAll(t *testing.T) {
+	t.Run("Should list all customers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+			assert.Equal(t, "/v1/customer/list", r.URL.Path)
+
+			resp := customer.ListCustomerResponse{
+				Data: []billing.Customer{
+					{ID: "cust_1234"},
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		c := customer.New(client)
+
+		ctx := context.Background()
+
+		response, err := c.ListAll(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+	})
+}
@@ -0,0 +1,41 @@
+package customer
+
+import (
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
+	"github.com/AbacatePay/abacatepay-go-sdk/phone"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+)
+
+var validate *validation.Validator
+
+type CreateCustomerBody struct {
+	Name      string `json:"name"      validate:"required"`
+	Cellphone string `json:"cellphone" validate:"required,cellphone"`
+	Email     string `json:"email"     validate:"required,email"`
+	TaxID     string `json:"taxId"     validate:"required,taxid"`
+}
+
+// CreateCustomerResponse carrega o cliente criado. O campo Data.ID pode ser
+// usado diretamente em billing.CreateBillingBody.CustomerId.
+type CreateCustomerResponse = fetch.Response[billing.Customer]
+
+type ListCustomerResponse = fetch.Response[[]billing.Customer]
+
+func init() {
+	validate = validation.New()
+}
+
+// normalized retorna uma cópia do corpo com o celular no formato E.164.
+func (p *CreateCustomerBody) normalized() *CreateCustomerBody {
+	body := *p
+	if cellphone, err := phone.Normalize(p.Cellphone); err == nil {
+		body.Cellphone = cellphone
+	}
+
+	return &body
+}
+
+func (p *CreateCustomerBody) Validate() error {
+	return validate.Struct(p)
+}
@@ -0,0 +1,53 @@
+package pixqrcode
+
+import (
+	"time"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+)
+
+var validate *validation.Validator
+
+type CreatePixQrCodeBody struct {
+	Amount      money.Money              `json:"amount"                validate:"required,gte=100"`
+	ExpiresIn   int                      `json:"expiresIn,omitempty"   validate:"omitempty,gte=1"`
+	Description string                   `json:"description,omitempty" validate:"omitempty,max=140"`
+	Customer    *billing.BillingCustomer `json:"customer,omitempty"`
+}
+
+type SimulatePaymentBody struct {
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+type PixQrCodeItem struct {
+	ID           string      `json:"id"`
+	Amount       money.Money `json:"amount"`
+	Status       string      `json:"status"`
+	DevMode      bool        `json:"devMode"`
+	BrCode       string      `json:"brCode"`
+	BrCodeBase64 string      `json:"brCodeBase64"`
+	PlatformFee  money.Money `json:"platformFee"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+	ExpiresAt    time.Time   `json:"expiresAt"`
+}
+
+type PixQrCodeResponse = fetch.Response[PixQrCodeItem]
+
+type CheckPixQrCodeItem struct {
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type CheckPixQrCodeResponse = fetch.Response[CheckPixQrCodeItem]
+
+func init() {
+	validate = validation.New()
+}
+
+func (p *CreatePixQrCodeBody) Validate() error {
+	return validate.Struct(p)
+}
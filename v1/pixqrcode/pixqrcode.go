@@ -0,0 +1,97 @@
+package pixqrcode
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+)
+
+type PixQrCode struct {
+	HttpClient *fetch.Fetch
+}
+
+func New(httpClient *fetch.Fetch) *PixQrCode {
+	return &PixQrCode{
+		HttpClient: httpClient,
+	}
+}
+
+func (p *PixQrCode) Create(
+	ctx context.Context,
+	body *CreatePixQrCodeBody,
+	opts ...fetch.RequestOptions,
+) (*PixQrCodeResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	normalized := *body
+	normalized.Customer = body.Customer.Normalize()
+
+	var response PixQrCodeResponse
+
+	resp, err := p.HttpClient.Post(ctx, "/v1/pixQrCode/create", &normalized, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (p *PixQrCode) Check(ctx context.Context, id string, opts ...fetch.RequestOptions) (*CheckPixQrCodeResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	var response CheckPixQrCodeResponse
+
+	resp, err := p.HttpClient.Get(ctx, "/v1/pixQrCode/check?id="+url.QueryEscape(id), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// SimulatePayment marca o QR Code como pago. Só funciona com chaves de API
+// em modo de desenvolvimento.
+func (p *PixQrCode) SimulatePayment(
+	ctx context.Context,
+	id string,
+	body *SimulatePaymentBody,
+	opts ...fetch.RequestOptions,
+) (*PixQrCodeResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	if body == nil {
+		body = &SimulatePaymentBody{Metadata: map[string]interface{}{}}
+	}
+
+	var response PixQrCodeResponse
+
+	resp, err := p.HttpClient.Post(ctx, "/v1/pixQrCode/simulate-payment?id="+url.QueryEscape(id), body, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
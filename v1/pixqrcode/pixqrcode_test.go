@@ -0,0 +1,146 @@
+package pixqrcode_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/pixqrcode"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Create new client with valid params", func(t *testing.T) {
+		client := pixqrcode.New(nil)
+		assert.NotNil(t, client)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should validate body", func(t *testing.T) {
+		client := pixqrcode.New(nil)
+
+		body := &pixqrcode.CreatePixQrCodeBody{
+			Amount: 10,
+		}
+
+		response, err := client.Create(context.Background(), body)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should create new pix qr code", func(t *testing.T) {
+		body := &pixqrcode.CreatePixQrCodeBody{
+			Amount:      100,
+			ExpiresIn:   3600,
+			Description: "Example",
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var bodyRef pixqrcode.CreatePixQrCodeBody
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+			assert.Equal(t, "/v1/pixQrCode/create", r.URL.Path)
+
+			defer r.Body.Close()
+
+			json.NewDecoder(r.Body).Decode(&bodyRef)
+
+			assert.Equal(t, *body, bodyRef)
+
+			resp := pixqrcode.PixQrCodeResponse{
+				Data: pixqrcode.PixQrCodeItem{
+					ID:           "pix_char_1234",
+					Amount:       100,
+					Status:       "PENDING",
+					BrCode:       "00020101021226950014br.gov.bcb.pix",
+					BrCodeBase64: "data:image/png;base64,iVBORw0KGgo",
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := pixqrcode.New(client).Create(context.Background(), body)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pix_char_1234", response.Data.ID)
+		assert.NotEmpty(t, response.Data.BrCode)
+		assert.NotEmpty(t, response.Data.BrCodeBase64)
+	})
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("Should require id", func(t *testing.T) {
+		response, err := pixqrcode.New(nil).Check(context.Background(), "")
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should check pix qr code status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/pixQrCode/check", r.URL.Path)
+			assert.Equal(t, "pix_char_1234", r.URL.Query().Get("id"))
+
+			resp := pixqrcode.CheckPixQrCodeResponse{
+				Data: pixqrcode.CheckPixQrCodeItem{
+					Status:    "PAID",
+					ExpiresAt: time.Now(),
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := pixqrcode.New(client).Check(context.Background(), "pix_char_1234")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "PAID", response.Data.Status)
+	})
+}
+
+func TestSimulatePayment(t *testing.T) {
+	t.Run("Should simulate payment", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v1/pixQrCode/simulate-payment", r.URL.Path)
+			assert.Equal(t, "pix_char_1234", r.URL.Query().Get("id"))
+
+			resp := pixqrcode.PixQrCodeResponse{
+				Data: pixqrcode.PixQrCodeItem{
+					ID:      "pix_char_1234",
+					Status:  "PAID",
+					DevMode: true,
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := pixqrcode.New(client).SimulatePayment(context.Background(), "pix_char_1234", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "PAID", response.Data.Status)
+	})
+}
@@ -0,0 +1,21 @@
+package store
+
+import (
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+)
+
+// Balance contém os saldos da loja.
+type Balance struct {
+	Available money.Money `json:"available"`
+	Pending   money.Money `json:"pending"`
+	Blocked   money.Money `json:"blocked"`
+}
+
+type StoreItem struct {
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Balance Balance `json:"balance"`
+}
+
+type StoreResponse = fetch.Response[StoreItem]
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+)
+
+type Store struct {
+	HttpClient *fetch.Fetch
+}
+
+func New(httpClient *fetch.Fetch) *Store {
+	return &Store{
+		HttpClient: httpClient,
+	}
+}
+
+func (s *Store) Get(ctx context.Context, opts ...fetch.RequestOptions) (*StoreResponse, error) {
+	var response StoreResponse
+
+	resp, err := s.HttpClient.Get(ctx, "/v1/store/get", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,59 @@
+package store_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/store"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Create new client with valid params", func(t *testing.T) {
+		client := store.New(nil)
+		assert.NotNil(t, client)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("Should get store with balances", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+			assert.Equal(t, "/v1/store/get", r.URL.Path)
+
+			resp := store.StoreResponse{
+				Data: store.StoreItem{
+					ID:   "store_1234",
+					Name: "Example Store",
+					Balance: store.Balance{
+						Available: 15000,
+						Pending:   5000,
+						Blocked:   1000,
+					},
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := store.New(client).Get(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "store_1234", response.Data.ID)
+		assert.Equal(t, money.Money(15000), response.Data.Balance.Available)
+		assert.Equal(t, money.Money(5000), response.Data.Balance.Pending)
+		assert.Equal(t, money.Money(1000), response.Data.Balance.Blocked)
+	})
+}
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/pixqrcode"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/withdraw"
+)
+
+type EventType string
+
+const (
+	BillingPaid    EventType = "billing.paid"
+	PixPaid        EventType = "pix.paid"
+	WithdrawDone   EventType = "withdraw.done"
+	WithdrawFailed EventType = "withdraw.failed"
+)
+
+// EventHeader contém os campos comuns a todos os eventos.
+type EventHeader struct {
+	ID        string    `json:"id"`
+	Type      EventType `json:"event"`
+	DevMode   bool      `json:"devMode"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Event é um evento verificado cujo conteúdo ainda não foi decodificado.
+type Event struct {
+	EventHeader
+	Data json.RawMessage `json:"data"`
+}
+
+type Payment struct {
+	Amount money.Money    `json:"amount"`
+	Fee    money.Money    `json:"fee"`
+	Method billing.Method `json:"method"`
+}
+
+type BillingPaidData struct {
+	Payment Payment             `json:"payment"`
+	Billing billing.BillingItem `json:"billing"`
+}
+
+type BillingPaidEvent struct {
+	EventHeader
+	Data BillingPaidData `json:"data"`
+}
+
+type PixPaidData struct {
+	Payment   Payment                 `json:"payment"`
+	PixQrCode pixqrcode.PixQrCodeItem `json:"pixQrCode"`
+}
+
+type PixPaidEvent struct {
+	EventHeader
+	Data PixPaidData `json:"data"`
+}
+
+type WithdrawData struct {
+	Transaction withdraw.WithdrawItem `json:"transaction"`
+}
+
+// WithdrawEvent é usado tanto por withdraw.done quanto por withdraw.failed.
+type WithdrawEvent struct {
+	EventHeader
+	Data WithdrawData `json:"data"`
+}
+
+// Decode converte o evento no tipo correspondente a Type: *BillingPaidEvent,
+// *PixPaidEvent ou *WithdrawEvent. Eventos desconhecidos retornam ErrUnknownEvent.
+func (e *Event) Decode() (interface{}, error) {
+	var event, data interface{}
+
+	switch e.Type {
+	case BillingPaid:
+		ev := &BillingPaidEvent{EventHeader: e.EventHeader}
+		event, data = ev, &ev.Data
+	case PixPaid:
+		ev := &PixPaidEvent{EventHeader: e.EventHeader}
+		event, data = ev, &ev.Data
+	case WithdrawDone, WithdrawFailed:
+		ev := &WithdrawEvent{EventHeader: e.EventHeader}
+		event, data = ev, &ev.Data
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, e.Type)
+	}
+
+	if len(e.Data) > 0 {
+		if err := json.Unmarshal(e.Data, data); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedPayload, err)
+		}
+	}
+
+	return event, nil
+}
@@ -0,0 +1,179 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+const DefaultMaxBodySize int64 = 1 << 20
+
+// Handler é um http.Handler que verifica, decodifica e despacha os eventos
+// para os callbacks registrados. Responde 2xx quando o evento foi processado
+// (ou não há callback para ele), 4xx quando a requisição é inválida e 5xx
+// quando um callback retorna erro, para que a AbacatePay tente novamente.
+type Handler struct {
+	webhook     *Webhook
+	MaxBodySize int64
+	// Store, quando configurado, evita que eventos com o mesmo ID sejam
+	// processados mais de uma vez. Se o Store implementar Completer, o evento
+	// é marcado como concluído após o callback.
+	Store Store
+
+	onBillingPaid    func(context.Context, *BillingPaidEvent) error
+	onPixPaid        func(context.Context, *PixPaidEvent) error
+	onWithdrawDone   func(context.Context, *WithdrawEvent) error
+	onWithdrawFailed func(context.Context, *WithdrawEvent) error
+	fallback         func(context.Context, *Event) error
+}
+
+func NewHandler(webhook *Webhook) *Handler {
+	return &Handler{
+		webhook:     webhook,
+		MaxBodySize: DefaultMaxBodySize,
+	}
+}
+
+func (h *Handler) OnBillingPaid(fn func(context.Context, *BillingPaidEvent) error) {
+	h.onBillingPaid = fn
+}
+
+func (h *Handler) OnPixPaid(fn func(context.Context, *PixPaidEvent) error) {
+	h.onPixPaid = fn
+}
+
+func (h *Handler) OnWithdrawDone(fn func(context.Context, *WithdrawEvent) error) {
+	h.onWithdrawDone = fn
+}
+
+func (h *Handler) OnWithdrawFailed(fn func(context.Context, *WithdrawEvent) error) {
+	h.onWithdrawFailed = fn
+}
+
+// OnUnknown registra o callback chamado para eventos sem tipo conhecido.
+func (h *Handler) OnUnknown(fn func(context.Context, *Event) error) {
+	h.fallback = fn
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.MaxBodySize))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	event, err := h.webhook.Verify(payload, r.Header.Get(SignatureHeader), r.URL.Query().Get(SecretQueryParam))
+	if err != nil {
+		http.Error(w, err.Error(), statusFor(err))
+		return
+	}
+
+	if h.Store != nil && event.ID != "" {
+		claimed, err := h.Store.Claim(r.Context(), event.ID)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if !claimed {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		// O ID é liberado sempre que o callback não concluir, inclusive em
+		// caso de panic, para que a próxima entrega seja processada.
+		completed := false
+		defer func() {
+			ctx := context.WithoutCancel(r.Context())
+			if !completed {
+				h.Store.Release(ctx, event.ID)
+				return
+			}
+
+			if completer, ok := h.Store.(Completer); ok {
+				completer.Complete(ctx, event.ID)
+			}
+		}()
+
+		if err := h.dispatch(r.Context(), event); err != nil {
+			status := statusFor(err)
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+
+		completed = true
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		status := statusFor(err)
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) dispatch(ctx context.Context, event *Event) error {
+	decoded, err := event.Decode()
+	if errors.Is(err, ErrUnknownEvent) {
+		if h.fallback == nil {
+			return nil
+		}
+
+		return h.fallback(ctx, event)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch ev := decoded.(type) {
+	case *BillingPaidEvent:
+		if h.onBillingPaid != nil {
+			return h.onBillingPaid(ctx, ev)
+		}
+	case *PixPaidEvent:
+		if h.onPixPaid != nil {
+			return h.onPixPaid(ctx, ev)
+		}
+	case *WithdrawEvent:
+		if ev.Type == WithdrawDone && h.onWithdrawDone != nil {
+			return h.onWithdrawDone(ctx, ev)
+		}
+
+		if ev.Type == WithdrawFailed && h.onWithdrawFailed != nil {
+			return h.onWithdrawFailed(ctx, ev)
+		}
+	}
+
+	return nil
+}
+
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, ErrMissingSignature),
+		errors.Is(err, ErrInvalidSignature),
+		errors.Is(err, ErrInvalidSecret):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrMalformedPayload),
+		errors.Is(err, ErrStaleEvent):
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
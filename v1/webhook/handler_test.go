@@ -0,0 +1,161 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/webhook"
+)
+
+func newRequest(payload []byte, signature, secret string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/webhooks/abacatepay?webhookSecret="+secret, bytes.NewReader(payload))
+	r.Header.Set(webhook.SignatureHeader, signature)
+	return r
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("Should dispatch billing.paid", func(t *testing.T) {
+		var received *webhook.BillingPaidEvent
+
+		h := webhook.NewHandler(newWebhook(t))
+		h.OnBillingPaid(func(ctx context.Context, event *webhook.BillingPaidEvent) error {
+			received = event
+			return nil
+		})
+
+		payload := billingPaidPayload(time.Now())
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotNil(t, received)
+		assert.Equal(t, "bill_1234", received.Data.Billing.ID)
+	})
+
+	t.Run("Should work mounted on an httptest server", func(t *testing.T) {
+		called := false
+
+		h := webhook.NewHandler(newWebhook(t))
+		h.OnBillingPaid(func(ctx context.Context, event *webhook.BillingPaidEvent) error {
+			called = true
+			return nil
+		})
+
+		mux := http.NewServeMux()
+		mux.Handle("/webhooks/abacatepay", h)
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		payload := billingPaidPayload(time.Now())
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/webhooks/abacatepay?webhookSecret="+testSecret, bytes.NewReader(payload))
+		assert.NoError(t, err)
+		req.Header.Set(webhook.SignatureHeader, sign(payload))
+
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.True(t, called)
+	})
+
+	t.Run("Should return 401 on invalid signature", func(t *testing.T) {
+		h := webhook.NewHandler(newWebhook(t))
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(billingPaidPayload(time.Now()), "invalid", testSecret))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Should return 401 on invalid secret", func(t *testing.T) {
+		h := webhook.NewHandler(newWebhook(t))
+
+		payload := billingPaidPayload(time.Now())
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), "other-secret"))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Should return 400 on malformed payload", func(t *testing.T) {
+		h := webhook.NewHandler(newWebhook(t))
+
+		payload := eventPayload("billing.paid", `{"payment": "invalid"}`)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Should return 413 when body is too large", func(t *testing.T) {
+		h := webhook.NewHandler(newWebhook(t))
+		h.MaxBodySize = 16
+
+		payload := billingPaidPayload(time.Now())
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("Should return 405 on non POST requests", func(t *testing.T) {
+		h := webhook.NewHandler(newWebhook(t))
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhooks/abacatepay", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("Should return 500 when callback fails", func(t *testing.T) {
+		h := webhook.NewHandler(newWebhook(t))
+		h.OnBillingPaid(func(ctx context.Context, event *webhook.BillingPaidEvent) error {
+			return errors.New("database unavailable")
+		})
+
+		payload := billingPaidPayload(time.Now())
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "database unavailable")
+	})
+
+	t.Run("Should call fallback for unknown events", func(t *testing.T) {
+		var received *webhook.Event
+
+		h := webhook.NewHandler(newWebhook(t))
+		h.OnUnknown(func(ctx context.Context, event *webhook.Event) error {
+			received = event
+			return nil
+		})
+
+		payload := eventPayload("billing.refunded", `{}`)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, webhook.EventType("billing.refunded"), received.Type)
+	})
+
+	t.Run("Should acknowledge events without callback", func(t *testing.T) {
+		h := webhook.NewHandler(newWebhook(t))
+
+		payload := eventPayload("pix.paid", `{}`)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, strings.TrimSpace(rec.Body.String()))
+	})
+}
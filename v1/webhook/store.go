@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store registra os IDs de eventos já recebidos para que entregas repetidas
+// sejam confirmadas sem serem processadas novamente.
+type Store interface {
+	// Claim registra o ID e retorna false se ele já havia sido registrado.
+	Claim(ctx context.Context, id string) (bool, error)
+	// Release remove o ID, permitindo que uma nova entrega seja processada.
+	// É chamado quando o callback do evento falha.
+	Release(ctx context.Context, id string) error
+}
+
+// DefaultLease é por quanto tempo um evento fica reservado enquanto o callback
+// executa. Se o processo cair antes de concluir, uma nova entrega é aceita
+// após esse prazo.
+const DefaultLease = 5 * time.Minute
+
+// Completer é implementado pelos Stores que distinguem eventos em
+// processamento de eventos concluídos. O Handler chama Complete quando o
+// callback termina sem erro; até lá, o ID só fica reservado pelo lease.
+type Completer interface {
+	Complete(ctx context.Context, id string) error
+}
+
+// memorySweepInterval é o número de chamadas a Claim entre as remoções dos
+// IDs expirados do MemoryStore.
+const memorySweepInterval = 1024
+
+// MemoryStore é um Store em memória cujos IDs concluídos expiram após o TTL.
+// Não é compartilhado entre processos.
+type MemoryStore struct {
+	// Lease é o prazo de reserva dos eventos ainda não concluídos. Quando
+	// zero, é usado DefaultLease. Deve ser definido antes do primeiro uso.
+	Lease time.Duration
+
+	mu     sync.Mutex
+	ttl    time.Duration
+	events map[string]time.Time
+	claims int
+}
+
+func NewMemoryStore(ttl time.Duration) *MemoryStore {
+	return &MemoryStore{
+		ttl:    ttl,
+		events: make(map[string]time.Time),
+	}
+}
+
+func (s *MemoryStore) Claim(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	s.claims++
+	if s.claims >= memorySweepInterval {
+		s.claims = 0
+		s.sweep(now)
+	}
+
+	if expiresAt, ok := s.events[id]; ok && !now.After(expiresAt) {
+		return false, nil
+	}
+
+	lease := s.Lease
+	if lease <= 0 {
+		lease = DefaultLease
+	}
+
+	s.events[id] = now.Add(min(lease, s.ttl))
+
+	return true, nil
+}
+
+// Complete marca o evento como concluído, mantendo o ID até o fim do TTL.
+func (s *MemoryStore) Complete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events[id] = time.Now().Add(s.ttl)
+
+	return nil
+}
+
+// Len retorna o número de IDs mantidos, incluindo os expirados que ainda não
+// foram removidos.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.events)
+}
+
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, expiresAt := range s.events {
+		if now.After(expiresAt) {
+			delete(s.events, key)
+		}
+	}
+}
+
+func (s *MemoryStore) Release(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.events, id)
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const DefaultSQLTable = "abacatepay_webhook_events"
+
+// SQLStore é um Store baseado em database/sql. A tabela pode ser criada com
+// Migrate e os registros antigos removidos com Cleanup. Eventos reservados e
+// não concluídos podem ser reprocessados após o lease.
+type SQLStore struct {
+	db      *sql.DB
+	table   string
+	lease   time.Duration
+	bindvar func(n int) string
+}
+
+type SQLOption func(*SQLStore)
+
+func WithTable(table string) SQLOption {
+	return func(s *SQLStore) {
+		s.table = table
+	}
+}
+
+// WithLease define o prazo de reserva dos eventos não concluídos. O padrão é
+// DefaultLease.
+func WithLease(lease time.Duration) SQLOption {
+	return func(s *SQLStore) {
+		s.lease = lease
+	}
+}
+
+// WithDollarPlaceholders usa $1, $2... nas consultas, como exigido pelo PostgreSQL.
+func WithDollarPlaceholders() SQLOption {
+	return func(s *SQLStore) {
+		s.bindvar = func(n int) string { return fmt.Sprintf("$%d", n) }
+	}
+}
+
+func NewSQLStore(db *sql.DB, opts ...SQLOption) *SQLStore {
+	s := &SQLStore{
+		db:      db,
+		table:   DefaultSQLTable,
+		lease:   DefaultLease,
+		bindvar: func(int) string { return "?" },
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *SQLStore) Migrate(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (id VARCHAR(255) PRIMARY KEY, received_at TIMESTAMP NOT NULL, completed_at TIMESTAMP NULL)",
+		s.table,
+	))
+	if err != nil {
+		return fmt.Errorf("error on creating webhook events table: %v", err)
+	}
+
+	return nil
+}
+
+func (s *SQLStore) Claim(ctx context.Context, id string) (bool, error) {
+	now := time.Now().UTC()
+
+	_, insertErr := s.db.ExecContext(ctx, fmt.Sprintf(
+		"INSERT INTO %s (id, received_at) VALUES (%s, %s)",
+		s.table, s.bindvar(1), s.bindvar(2),
+	), id, now)
+	if insertErr == nil {
+		return true, nil
+	}
+
+	// Reserva vencida de um evento não concluído, por exemplo após uma queda
+	// do processo durante o callback.
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(
+		"UPDATE %s SET received_at = %s WHERE id = %s AND completed_at IS NULL AND received_at < %s",
+		s.table, s.bindvar(1), s.bindvar(2), s.bindvar(3),
+	), now, id, now.Add(-s.lease))
+	if err != nil {
+		return false, fmt.Errorf("error on claiming webhook event: %w (lookup: %w)", insertErr, err)
+	}
+	if taken, err := result.RowsAffected(); err == nil && taken == 1 {
+		return true, nil
+	}
+
+	// A violação de chave primária tem um formato diferente em cada driver,
+	// então confirmamos a duplicidade consultando o ID.
+	var count int
+	err = s.db.QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT COUNT(*) FROM %s WHERE id = %s",
+		s.table, s.bindvar(1),
+	), id).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("error on claiming webhook event: %w (lookup: %w)", insertErr, err)
+	}
+	if count == 0 {
+		return false, fmt.Errorf("error on claiming webhook event: %w", insertErr)
+	}
+
+	return false, nil
+}
+
+// Complete marca o evento como concluído, impedindo que seja reprocessado.
+func (s *SQLStore) Complete(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(
+		"UPDATE %s SET completed_at = %s WHERE id = %s",
+		s.table, s.bindvar(1), s.bindvar(2),
+	), time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("error on completing webhook event: %v", err)
+	}
+
+	return nil
+}
+
+func (s *SQLStore) Release(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(
+		"DELETE FROM %s WHERE id = %s",
+		s.table, s.bindvar(1),
+	), id)
+	if err != nil {
+		return fmt.Errorf("error on releasing webhook event: %v", err)
+	}
+
+	return nil
+}
+
+// Cleanup remove os eventos recebidos há mais de olderThan.
+func (s *SQLStore) Cleanup(ctx context.Context, olderThan time.Duration) error {
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(
+		"DELETE FROM %s WHERE received_at < %s",
+		s.table, s.bindvar(1),
+	), time.Now().UTC().Add(-olderThan))
+	if err != nil {
+		return fmt.Errorf("error on cleaning up webhook events: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package webhook_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/webhook"
+)
+
+func newSQLStore(t *testing.T) *webhook.SQLStore {
+	db, err := sql.Open("sqlite", ":memory:")
+	assert.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	store := webhook.NewSQLStore(db)
+	assert.NoError(t, store.Migrate(context.Background()))
+
+	return store
+}
+
+func testStore(t *testing.T, store webhook.Store) {
+	ctx := context.Background()
+
+	claimed, err := store.Claim(ctx, "log_1234")
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = store.Claim(ctx, "log_1234")
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+
+	assert.NoError(t, store.Release(ctx, "log_1234"))
+
+	claimed, err = store.Claim(ctx, "log_1234")
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestMemoryStore(t *testing.T) {
+	t.Run("Should claim and release events", func(t *testing.T) {
+		testStore(t, webhook.NewMemoryStore(time.Hour))
+	})
+
+	t.Run("Should expire events after TTL", func(t *testing.T) {
+		store := webhook.NewMemoryStore(time.Millisecond)
+		ctx := context.Background()
+
+		claimed, err := store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		time.Sleep(5 * time.Millisecond)
+
+		claimed, err = store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("Should expire claims that were not completed after the lease", func(t *testing.T) {
+		store := webhook.NewMemoryStore(time.Hour)
+		store.Lease = time.Millisecond
+		ctx := context.Background()
+
+		claimed, err := store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = store.Claim(ctx, "log_5678")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+		assert.NoError(t, store.Complete(ctx, "log_5678"))
+
+		time.Sleep(5 * time.Millisecond)
+
+		claimed, err = store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = store.Claim(ctx, "log_5678")
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("Should periodically remove expired events", func(t *testing.T) {
+		store := webhook.NewMemoryStore(time.Second)
+		ctx := context.Background()
+
+		expired := webhook.NewMemoryStore(-time.Second)
+		for i := 0; i < 2048; i++ {
+			_, err := expired.Claim(ctx, fmt.Sprintf("log_%d", i))
+			assert.NoError(t, err)
+		}
+
+		assert.Less(t, expired.Len(), 2048)
+
+		for i := 0; i < 2048; i++ {
+			_, err := store.Claim(ctx, fmt.Sprintf("log_%d", i))
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, 2048, store.Len())
+	})
+}
+
+func TestSQLStore(t *testing.T) {
+	t.Run("Should claim and release events", func(t *testing.T) {
+		testStore(t, newSQLStore(t))
+	})
+
+	t.Run("Should clean up old events", func(t *testing.T) {
+		store := newSQLStore(t)
+		ctx := context.Background()
+
+		_, err := store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+
+		assert.NoError(t, store.Cleanup(ctx, -time.Minute))
+
+		claimed, err := store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("Should expire claims that were not completed after the lease", func(t *testing.T) {
+		db, err := sql.Open("sqlite", ":memory:")
+		assert.NoError(t, err)
+		db.SetMaxOpenConns(1)
+		defer db.Close()
+
+		store := webhook.NewSQLStore(db, webhook.WithLease(time.Millisecond))
+		ctx := context.Background()
+		assert.NoError(t, store.Migrate(ctx))
+
+		claimed, err := store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = store.Claim(ctx, "log_5678")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+		assert.NoError(t, store.Complete(ctx, "log_5678"))
+
+		time.Sleep(5 * time.Millisecond)
+
+		claimed, err = store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = store.Claim(ctx, "log_1234")
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+
+		claimed, err = store.Claim(ctx, "log_5678")
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("Should report insert and lookup errors", func(t *testing.T) {
+		db, err := sql.Open("sqlite", ":memory:")
+		assert.NoError(t, err)
+		defer db.Close()
+
+		_, err = webhook.NewSQLStore(db).Claim(context.Background(), "log_1234")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "lookup: ")
+	})
+}
+
+func TestHandlerDeduplication(t *testing.T) {
+	t.Run("Should acknowledge repeated events without reprocessing", func(t *testing.T) {
+		calls := 0
+
+		h := webhook.NewHandler(newWebhook(t))
+		h.Store = newSQLStore(t)
+		h.OnBillingPaid(func(ctx context.Context, event *webhook.BillingPaidEvent) error {
+			calls++
+			return nil
+		})
+
+		payload := billingPaidPayload(time.Now())
+		for i := 0; i < 2; i++ {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Should reprocess events whose callback failed", func(t *testing.T) {
+		calls := 0
+
+		h := webhook.NewHandler(newWebhook(t))
+		h.Store = webhook.NewMemoryStore(time.Hour)
+		h.OnBillingPaid(func(ctx context.Context, event *webhook.BillingPaidEvent) error {
+			calls++
+			if calls == 1 {
+				return errors.New("database unavailable")
+			}
+			return nil
+		})
+
+		payload := billingPaidPayload(time.Now())
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Should reprocess events whose callback panicked", func(t *testing.T) {
+		calls := 0
+
+		h := webhook.NewHandler(newWebhook(t))
+		h.Store = newSQLStore(t)
+		h.OnBillingPaid(func(ctx context.Context, event *webhook.BillingPaidEvent) error {
+			calls++
+			if calls == 1 {
+				panic("unexpected nil pointer")
+			}
+			return nil
+		})
+
+		payload := billingPaidPayload(time.Now())
+
+		assert.Panics(t, func() {
+			h.ServeHTTP(httptest.NewRecorder(), newRequest(payload, sign(payload), testSecret))
+		})
+
+		for i := 0; i < 2; i++ {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, newRequest(payload, sign(payload), testSecret))
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	SignatureHeader  = "X-Webhook-Signature"
+	SecretQueryParam = "webhookSecret"
+	DefaultTolerance = 5 * time.Minute
+)
+
+var (
+	ErrInvalidConfig    = errors.New("webhook secret or signing key is required")
+	ErrMissingSignature = errors.New("missing webhook signature")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidSecret    = errors.New("invalid webhook secret")
+	ErrStaleEvent       = errors.New("webhook event timestamp outside tolerance")
+	ErrMalformedPayload = errors.New("malformed webhook payload")
+	ErrUnknownEvent     = errors.New("unknown webhook event")
+)
+
+type Config struct {
+	// Secret é o valor do parâmetro webhookSecret configurado na URL do webhook.
+	Secret string
+	// SigningKey é a chave usada no HMAC-SHA256 do cabeçalho X-Webhook-Signature.
+	SigningKey string
+	// Tolerance é a diferença máxima aceita entre o createdAt do evento e o
+	// relógio local. Quando zero, é usado DefaultTolerance.
+	Tolerance time.Duration
+	// AllowMissingTimestamp aceita eventos sem createdAt. Por padrão eles são
+	// rejeitados com ErrStaleEvent, já que não há como protegê-los contra
+	// reenvio.
+	AllowMissingTimestamp bool
+}
+
+type Webhook struct {
+	secret       string
+	signingKey   []byte
+	tolerance    time.Duration
+	allowMissing bool
+}
+
+// New cria um verificador de webhooks. Apenas as verificações cujos valores
+// foram configurados (Secret e/ou SigningKey) são aplicadas.
+func New(config *Config) (*Webhook, error) {
+	if config == nil || (config.Secret == "" && config.SigningKey == "") {
+		return nil, ErrInvalidConfig
+	}
+
+	tolerance := config.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+
+	return &Webhook{
+		secret:       config.Secret,
+		signingKey:   []byte(config.SigningKey),
+		tolerance:    tolerance,
+		allowMissing: config.AllowMissingTimestamp,
+	}, nil
+}
+
+// VerifySecret compara o webhookSecret recebido com o configurado.
+func (w *Webhook) VerifySecret(secret string) error {
+	if w.secret == "" {
+		return nil
+	}
+
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(w.secret)) != 1 {
+		return ErrInvalidSecret
+	}
+
+	return nil
+}
+
+// VerifySignature valida a assinatura HMAC-SHA256 do corpo bruto. A assinatura
+// pode estar codificada em base64 ou em hexadecimal.
+func (w *Webhook) VerifySignature(payload []byte, signature string) error {
+	if len(w.signingKey) == 0 {
+		return nil
+	}
+
+	if signature == "" {
+		return ErrMissingSignature
+	}
+
+	mac := hmac.New(sha256.New, w.signingKey)
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	if decoded, err := base64.StdEncoding.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+		return nil
+	}
+
+	if decoded, err := hex.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+		return nil
+	}
+
+	return ErrInvalidSignature
+}
+
+// Parse decodifica o envelope do evento e rejeita eventos fora da tolerância
+// ou sem createdAt, salvo com Config.AllowMissingTimestamp. Não verifica
+// assinatura nem segredo; use Verify para isso.
+func (w *Webhook) Parse(payload []byte) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedPayload, err)
+	}
+
+	if event.Type == "" {
+		return nil, fmt.Errorf("%w: missing event type", ErrMalformedPayload)
+	}
+
+	if event.CreatedAt.IsZero() {
+		if !w.allowMissing {
+			return nil, fmt.Errorf("%w: missing createdAt", ErrStaleEvent)
+		}
+	} else {
+		age := time.Since(event.CreatedAt)
+		if age > w.tolerance || age < -w.tolerance {
+			return nil, ErrStaleEvent
+		}
+	}
+
+	return &event, nil
+}
+
+// Verify valida o segredo e a assinatura e então decodifica o evento.
+func (w *Webhook) Verify(payload []byte, signature, secret string) (*Event, error) {
+	if err := w.VerifySecret(secret); err != nil {
+		return nil, err
+	}
+
+	if err := w.VerifySignature(payload, signature); err != nil {
+		return nil, err
+	}
+
+	return w.Parse(payload)
+}
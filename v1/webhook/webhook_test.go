@@ -0,0 +1,177 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/billing"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/webhook"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/withdraw"
+)
+
+const (
+	testSecret     = "test-secret"
+	testSigningKey = "test-signing-key"
+)
+
+func sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(testSigningKey))
+	mac.Write(payload)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func billingPaidPayload(createdAt time.Time) []byte {
+	return []byte(fmt.Sprintf(`{
+		"id": "log_1234",
+		"event": "billing.paid",
+		"devMode": true,
+		"createdAt": %q,
+		"data": {
+			"payment": {"amount": 1000, "fee": 80, "method": "PIX"},
+			"billing": {"id": "bill_1234", "amount": 1000, "status": "PAID", "methods": ["PIX"], "frequency": "ONE_TIME"}
+		}
+	}`, createdAt.Format(time.RFC3339)))
+}
+
+func eventPayload(event, data string) []byte {
+	return []byte(fmt.Sprintf(
+		`{"id": "log_1234", "event": %q, "createdAt": %q, "data": %s}`,
+		event, time.Now().Format(time.RFC3339), data,
+	))
+}
+
+func newWebhook(t *testing.T) *webhook.Webhook {
+	w, err := webhook.New(&webhook.Config{
+		Secret:     testSecret,
+		SigningKey: testSigningKey,
+	})
+	assert.NoError(t, err)
+	return w
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Error if secret and signing key are empty", func(t *testing.T) {
+		w, err := webhook.New(&webhook.Config{})
+		assert.ErrorIs(t, err, webhook.ErrInvalidConfig)
+		assert.Nil(t, w)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("Should verify and decode billing.paid", func(t *testing.T) {
+		payload := billingPaidPayload(time.Now())
+
+		event, err := newWebhook(t).Verify(payload, sign(payload), testSecret)
+		assert.NoError(t, err)
+		assert.Equal(t, webhook.BillingPaid, event.Type)
+
+		decoded, err := event.Decode()
+		assert.NoError(t, err)
+
+		paid, ok := decoded.(*webhook.BillingPaidEvent)
+		assert.True(t, ok)
+		assert.Equal(t, "log_1234", paid.ID)
+		assert.Equal(t, money.Money(1000), paid.Data.Payment.Amount)
+		assert.Equal(t, billing.PIX, paid.Data.Payment.Method)
+		assert.Equal(t, "bill_1234", paid.Data.Billing.ID)
+		assert.Equal(t, billing.OneTime, paid.Data.Billing.Frequency)
+	})
+
+	t.Run("Should accept hex signatures", func(t *testing.T) {
+		payload := billingPaidPayload(time.Now())
+		mac := hmac.New(sha256.New, []byte(testSigningKey))
+		mac.Write(payload)
+
+		_, err := newWebhook(t).Verify(payload, hex.EncodeToString(mac.Sum(nil)), testSecret)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject missing signature", func(t *testing.T) {
+		_, err := newWebhook(t).Verify(billingPaidPayload(time.Now()), "", testSecret)
+		assert.ErrorIs(t, err, webhook.ErrMissingSignature)
+	})
+
+	t.Run("Should reject bad signature", func(t *testing.T) {
+		payload := billingPaidPayload(time.Now())
+		signature := sign(append([]byte{' '}, payload...))
+
+		_, err := newWebhook(t).Verify(payload, signature, testSecret)
+		assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("Should reject wrong secret", func(t *testing.T) {
+		payload := billingPaidPayload(time.Now())
+
+		_, err := newWebhook(t).Verify(payload, sign(payload), "other-secret")
+		assert.ErrorIs(t, err, webhook.ErrInvalidSecret)
+	})
+
+	t.Run("Should reject stale events", func(t *testing.T) {
+		payload := billingPaidPayload(time.Now().Add(-time.Hour))
+
+		_, err := newWebhook(t).Verify(payload, sign(payload), testSecret)
+		assert.ErrorIs(t, err, webhook.ErrStaleEvent)
+	})
+
+	t.Run("Should reject events without createdAt", func(t *testing.T) {
+		payload := []byte(`{"id": "log_1234", "event": "billing.paid", "data": {}}`)
+
+		_, err := newWebhook(t).Verify(payload, sign(payload), testSecret)
+		assert.ErrorIs(t, err, webhook.ErrStaleEvent)
+	})
+
+	t.Run("Should accept events without createdAt when allowed", func(t *testing.T) {
+		w, err := webhook.New(&webhook.Config{
+			Secret:                testSecret,
+			SigningKey:            testSigningKey,
+			AllowMissingTimestamp: true,
+		})
+		assert.NoError(t, err)
+
+		payload := []byte(`{"id": "log_1234", "event": "billing.paid", "data": {}}`)
+
+		_, err = w.Verify(payload, sign(payload), testSecret)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject malformed payloads", func(t *testing.T) {
+		payload := []byte(`{"event": `)
+
+		_, err := newWebhook(t).Verify(payload, sign(payload), testSecret)
+		assert.ErrorIs(t, err, webhook.ErrMalformedPayload)
+	})
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Should decode withdraw events", func(t *testing.T) {
+		payload := eventPayload("withdraw.failed", `{"transaction": {"id": "tran_1234", "status": "CANCELLED"}}`)
+
+		event, err := newWebhook(t).Parse(payload)
+		assert.NoError(t, err)
+
+		decoded, err := event.Decode()
+		assert.NoError(t, err)
+
+		failed, ok := decoded.(*webhook.WithdrawEvent)
+		assert.True(t, ok)
+		assert.Equal(t, webhook.WithdrawFailed, failed.Type)
+		assert.Equal(t, withdraw.Cancelled, failed.Data.Transaction.Status)
+	})
+
+	t.Run("Should return error for unknown events", func(t *testing.T) {
+		event, err := newWebhook(t).Parse(eventPayload("billing.refunded", `{}`))
+		assert.NoError(t, err)
+
+		decoded, err := event.Decode()
+		assert.ErrorIs(t, err, webhook.ErrUnknownEvent)
+		assert.Nil(t, decoded)
+	})
+}
@@ -0,0 +1,64 @@
+package withdraw
+
+import (
+	"time"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/validation"
+	"github.com/AbacatePay/abacatepay-go-sdk/money"
+)
+
+var validate *validation.Validator
+
+type CreateWithdrawBody struct {
+	ExternalId  string      `json:"externalId"            validate:"required"`
+	Method      string      `json:"method"                validate:"required,oneof=PIX"`
+	Amount      money.Money `json:"amount"                validate:"required,gte=350"`
+	Pix         *PixKey     `json:"pix"                   validate:"required"`
+	Description string      `json:"description,omitempty"`
+}
+
+type PixKey struct {
+	Type PixKeyType `json:"type" validate:"required,oneof=CPF CNPJ PHONE EMAIL RANDOM BR_CODE"`
+	Key  string     `json:"key"  validate:"required"`
+}
+
+type WithdrawItem struct {
+	ID          string      `json:"id"`
+	Status      Status      `json:"status"`
+	DevMode     bool        `json:"devMode"`
+	ReceiptURL  string      `json:"receiptUrl"`
+	Kind        string      `json:"kind"`
+	Amount      money.Money `json:"amount"`
+	PlatformFee money.Money `json:"platformFee"`
+	ExternalId  string      `json:"externalId"`
+	CreatedAt   time.Time   `json:"createdAt"`
+	UpdatedAt   time.Time   `json:"updatedAt"`
+}
+
+type WithdrawResponse = fetch.Response[WithdrawItem]
+
+type ListWithdrawResponse = fetch.Response[[]WithdrawItem]
+
+func init() {
+	validate = validation.New()
+}
+
+// withDefaults retorna uma cópia de p com o método PIX quando nenhum foi
+// informado, sem alterar o corpo do chamador.
+func (p *CreateWithdrawBody) withDefaults() *CreateWithdrawBody {
+	if p == nil {
+		return nil
+	}
+
+	body := *p
+	if body.Method == "" {
+		body.Method = "PIX"
+	}
+
+	return &body
+}
+
+func (p *CreateWithdrawBody) Validate() error {
+	return validate.Struct(p)
+}
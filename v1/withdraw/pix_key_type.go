@@ -0,0 +1,12 @@
+package withdraw
+
+type PixKeyType string
+
+const (
+	CPF    PixKeyType = "CPF"
+	CNPJ   PixKeyType = "CNPJ"
+	PHONE  PixKeyType = "PHONE"
+	EMAIL  PixKeyType = "EMAIL"
+	RANDOM PixKeyType = "RANDOM"
+	BRCODE PixKeyType = "BR_CODE"
+)
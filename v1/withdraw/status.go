@@ -0,0 +1,11 @@
+package withdraw
+
+type Status string
+
+const (
+	Pending   Status = "PENDING"
+	Expired   Status = "EXPIRED"
+	Cancelled Status = "CANCELLED"
+	Complete  Status = "COMPLETE"
+	Refunded  Status = "REFUNDED"
+)
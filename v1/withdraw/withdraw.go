@@ -0,0 +1,80 @@
+package withdraw
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+)
+
+type Withdraw struct {
+	HttpClient *fetch.Fetch
+}
+
+func New(httpClient *fetch.Fetch) *Withdraw {
+	return &Withdraw{
+		HttpClient: httpClient,
+	}
+}
+
+func (w *Withdraw) Create(
+	ctx context.Context,
+	body *CreateWithdrawBody,
+	opts ...fetch.RequestOptions,
+) (*WithdrawResponse, error) {
+	normalized := body.withDefaults()
+	if err := normalized.Validate(); err != nil {
+		return nil, err
+	}
+
+	var response WithdrawResponse
+
+	resp, err := w.HttpClient.Post(ctx, "/v1/withdraw/create", normalized, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (w *Withdraw) Get(ctx context.Context, externalId string, opts ...fetch.RequestOptions) (*WithdrawResponse, error) {
+	if externalId == "" {
+		return nil, fmt.Errorf("externalId is required")
+	}
+
+	var response WithdrawResponse
+
+	resp, err := w.HttpClient.Get(ctx, "/v1/withdraw/get?externalId="+url.QueryEscape(externalId), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (w *Withdraw) ListAll(ctx context.Context, opts ...fetch.RequestOptions) (*ListWithdrawResponse, error) {
+	var response ListWithdrawResponse
+
+	resp, err := w.HttpClient.Get(ctx, "/v1/withdraw/list", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fetch.ParseResponse(resp, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,154 @@
+package withdraw_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AbacatePay/abacatepay-go-sdk/internal/pkg/fetch"
+	"github.com/AbacatePay/abacatepay-go-sdk/v1/withdraw"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("Create new client with valid params", func(t *testing.T) {
+		client := withdraw.New(nil)
+		assert.NotNil(t, client)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should validate body", func(t *testing.T) {
+		client := withdraw.New(nil)
+
+		body := &withdraw.CreateWithdrawBody{
+			ExternalId: "withdraw-1234",
+			Amount:     5000,
+			Pix: &withdraw.PixKey{
+				Type: "UNKNOWN",
+				Key:  "test@example.com",
+			},
+		}
+
+		response, err := client.Create(context.Background(), body)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should create new withdraw", func(t *testing.T) {
+		body := &withdraw.CreateWithdrawBody{
+			ExternalId: "withdraw-1234",
+			Amount:     5000,
+			Pix: &withdraw.PixKey{
+				Type: withdraw.EMAIL,
+				Key:  "test@example.com",
+			},
+			Description: "Daily payout",
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var bodyRef withdraw.CreateWithdrawBody
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+			assert.Equal(t, "/v1/withdraw/create", r.URL.Path)
+
+			defer r.Body.Close()
+
+			json.NewDecoder(r.Body).Decode(&bodyRef)
+
+			expected := *body
+			expected.Method = "PIX"
+
+			assert.Equal(t, expected, bodyRef)
+
+			resp := withdraw.WithdrawResponse{
+				Data: withdraw.WithdrawItem{
+					ID:         "tran_1234",
+					Status:     withdraw.Pending,
+					Amount:     5000,
+					ExternalId: "withdraw-1234",
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := withdraw.New(client).Create(context.Background(), body)
+
+		assert.NoError(t, err)
+		assert.Equal(t, withdraw.Pending, response.Data.Status)
+		assert.Empty(t, body.Method)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("Should require externalId", func(t *testing.T) {
+		response, err := withdraw.New(nil).Get(context.Background(), "")
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("Should get withdraw by externalId", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/withdraw/get", r.URL.Path)
+			assert.Equal(t, "withdraw-1234", r.URL.Query().Get("externalId"))
+
+			resp := withdraw.WithdrawResponse{
+				Data: withdraw.WithdrawItem{
+					ID:         "tran_1234",
+					Status:     withdraw.Complete,
+					ExternalId: "withdraw-1234",
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := withdraw.New(client).Get(context.Background(), "withdraw-1234")
+
+		assert.NoError(t, err)
+		assert.Equal(t, withdraw.Complete, response.Data.Status)
+	})
+}
+
+func TestListAll(t *testing.T) {
+	t.Run("Should list all withdraws", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/withdraw/list", r.URL.Path)
+
+			resp := withdraw.ListWithdrawResponse{
+				Data: []withdraw.WithdrawItem{
+					{ID: "tran_1234", Status: withdraw.Complete},
+				},
+			}
+
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, err := fetch.New("test-key", server.URL, "1.0.0", 10*time.Second)
+		assert.NoError(t, err)
+
+		response, err := withdraw.New(client).ListAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+	})
+}